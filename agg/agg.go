@@ -0,0 +1,186 @@
+// Package agg provides SQL aggregate functions over slices of nullable values.
+//
+// Like SQL, every function skips NULL, and returns NULL when nothing is left:
+//
+//	total, err := agg.Sum(prices)     // nullable.Int64
+//	average := agg.Avg(prices)        // nullable.Float64
+//	latest := agg.MaxFunc(times, time.Time.Compare)
+package agg
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+)
+
+// Number is the plain type of every numeric nullable type
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// settable constrains pointer to nullable type N which holds T, so the result can be created
+type settable[N any, T any] interface {
+	*N
+	Set(value *T)
+}
+
+// addition describes overflowed sum as Value of *nullable.OverflowError
+type addition struct {
+	total interface{}
+	value interface{}
+}
+
+func (a addition) String() string {
+	return fmt.Sprintf("%v + %v", a.total, a.value)
+}
+
+// Sum is SQL SUM, integers fail with *nullable.OverflowError instead of wrapping around
+func Sum[N nullable.Nullable[T], T Number, P settable[N, T]](values []N) (N, error) {
+	var result N
+	var total T
+	isFound := false
+	for _, value := range values {
+		current := value.Get()
+		if current == nil {
+			continue
+		}
+		sum := total + *current
+		if (*current > 0 && sum < total) || (*current < 0 && sum > total) {
+			return result, &nullable.OverflowError{Target: reflect.TypeOf(result), Value: addition{total, *current}}
+		}
+		total = sum
+		isFound = true
+	}
+	if isFound {
+		P(&result).Set(&total)
+	}
+	return result, nil
+}
+
+// Avg is SQL AVG, calculated in double precision float
+func Avg[N nullable.Nullable[T], T Number](values []N) nullable.Float64 {
+	var mean float64
+	count := 0
+	for _, value := range values {
+		if current := value.Get(); current != nil {
+			count++
+			// Running mean doesn't overflow like sum of large integers does
+			mean += (float64(*current) - mean) / float64(count)
+		}
+	}
+	if count == 0 {
+		return nullable.NewFloat64(nil)
+	}
+	return nullable.NewFloat64Value(mean)
+}
+
+// Min is SQL MIN, floats are compared with cmp.Compare, so NaN is the smallest
+func Min[N nullable.Nullable[T], T cmp.Ordered](values []N) N {
+	return MinFunc(values, cmp.Compare[T])
+}
+
+// Max is SQL MAX, floats are compared with cmp.Compare, so NaN is the smallest
+func Max[N nullable.Nullable[T], T cmp.Ordered](values []N) N {
+	return MaxFunc(values, cmp.Compare[T])
+}
+
+// MinFunc is SQL MIN with custom comparison, like time.Time.Compare
+func MinFunc[N nullable.Nullable[T], T any](values []N, compare func(a, b T) int) N {
+	return pick(values, func(current, picked T) bool { return compare(current, picked) < 0 })
+}
+
+// MaxFunc is SQL MAX with custom comparison, like time.Time.Compare
+func MaxFunc[N nullable.Nullable[T], T any](values []N, compare func(a, b T) int) N {
+	return pick(values, func(current, picked T) bool { return compare(current, picked) > 0 })
+}
+
+// pick returns the first non-NULL value which no other value replaces
+func pick[N nullable.Nullable[T], T any](values []N, replaces func(current, picked T) bool) N {
+	var result N
+	var picked T
+	isPicked := false
+	for _, value := range values {
+		current := value.Get()
+		if current != nil && (!isPicked || replaces(*current, picked)) {
+			result, picked, isPicked = value, *current, true
+		}
+	}
+	return result
+}
+
+// Count is SQL COUNT of a column, only non-NULL values are counted
+func Count[N nullable.Nullable[T], T any](values []N) int {
+	count := 0
+	for _, value := range values {
+		if value.Get() != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// CountDistinct is SQL COUNT(DISTINCT ...), values are compared with == after
+// normalizing: times are equal at the same instant regardless of location or
+// monotonic reading, and every NaN is one value like in PostgreSQL
+func CountDistinct[N nullable.Nullable[T], T comparable](values []N) int {
+	seen := make(map[interface{}]struct{})
+	for _, value := range values {
+		if current := value.Get(); current != nil {
+			seen[distinctKey(*current)] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
+// nanKey is the distinct key of every NaN, because NaN != NaN
+type nanKey struct{}
+
+// distinctKey normalizes value, so equal values have the same map key
+func distinctKey(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case time.Time:
+		return typed.UTC().Round(0)
+	case float64:
+		if math.IsNaN(typed) {
+			return nanKey{}
+		}
+	case float32:
+		if math.IsNaN(float64(typed)) {
+			return nanKey{}
+		}
+	}
+	return value
+}
+
+// Coalesce is SQL COALESCE, returns the first non-NULL value
+func Coalesce[N nullable.Nullable[T], T any](values ...N) N {
+	return First(values)
+}
+
+// First returns the first non-NULL value
+func First[N nullable.Nullable[T], T any](values []N) N {
+	for _, value := range values {
+		if value.Get() != nil {
+			return value
+		}
+	}
+	var null N
+	return null
+}
+
+// Last returns the last non-NULL value
+func Last[N nullable.Nullable[T], T any](values []N) N {
+	for i := len(values) - 1; i >= 0; i-- {
+		if values[i].Get() != nil {
+			return values[i]
+		}
+	}
+	var null N
+	return null
+}
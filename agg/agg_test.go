@@ -0,0 +1,128 @@
+package agg_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+	"github.com/Thor-x86/nullable/agg"
+	"gorm.io/gorm/utils/tests"
+)
+
+func int64s(values ...interface{}) []nullable.Int64 {
+	result := make([]nullable.Int64, len(values))
+	for i, value := range values {
+		if value != nil {
+			result[i] = nullable.NewInt64Value(int64(value.(int)))
+		}
+	}
+	return result
+}
+
+func TestSum(t *testing.T) {
+	total, err := agg.Sum(int64s(3, nil, 4, -2))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, total, nullable.NewInt64Value(5))
+
+	total, err = agg.Sum(int64s(nil, nil))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, total.IsNull(), true)
+
+	total, err = agg.Sum([]nullable.Int64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, total.IsNull(), true)
+
+	ratios := []nullable.Float64{nullable.NewFloat64Value(0.5), nullable.NewFloat64(nil), nullable.NewFloat64Value(0.25)}
+	ratioTotal, err := agg.Sum(ratios)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, ratioTotal, nullable.NewFloat64Value(0.75))
+}
+
+func TestSumOverflow(t *testing.T) {
+	levels := []nullable.Int8{nullable.NewInt8Value(100), nullable.NewInt8(nil), nullable.NewInt8Value(28)}
+	if _, err := agg.Sum(levels); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while summing 100 and 28 as Int8, got %v", err)
+	}
+
+	counters := []nullable.Uint64{nullable.NewUint64Value(math.MaxUint64), nullable.NewUint64Value(1)}
+	if _, err := agg.Sum(counters); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while summing max Uint64 and 1, got %v", err)
+	}
+
+	debts := []nullable.Int64{nullable.NewInt64Value(math.MinInt64), nullable.NewInt64Value(-1)}
+	if _, err := agg.Sum(debts); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while summing min Int64 and -1, got %v", err)
+	}
+}
+
+func TestAvg(t *testing.T) {
+	tests.AssertEqual(t, agg.Avg(int64s(1, nil, 2)), nullable.NewFloat64Value(1.5))
+	tests.AssertEqual(t, agg.Avg(int64s(nil)).IsNull(), true)
+
+	huge := int64s(math.MaxInt64, math.MaxInt64)
+	tests.AssertEqual(t, agg.Avg(huge), nullable.NewFloat64Value(math.MaxInt64))
+}
+
+func TestMinMax(t *testing.T) {
+	values := int64s(nil, 3, -7, nil, 5)
+	tests.AssertEqual(t, agg.Min(values), nullable.NewInt64Value(-7))
+	tests.AssertEqual(t, agg.Max(values), nullable.NewInt64Value(5))
+	tests.AssertEqual(t, agg.Min(int64s(nil)).IsNull(), true)
+
+	names := []nullable.String{nullable.NewStringValue("thor"), nullable.NewString(nil), nullable.NewStringValue("loki")}
+	tests.AssertEqual(t, agg.Min(names), nullable.NewStringValue("loki"))
+
+	times := []nullable.Time{
+		nullable.NewTimeValue(time.Unix(1630922400, 0)),
+		nullable.NewTime(nil),
+		nullable.NewTimeValue(time.Unix(1630926000, 0)),
+	}
+	tests.AssertEqual(t, agg.MaxFunc(times, time.Time.Compare), times[2])
+	tests.AssertEqual(t, agg.MinFunc(times, time.Time.Compare), times[0])
+}
+
+func TestCount(t *testing.T) {
+	values := int64s(nil, 3, 3, nil, 5)
+	tests.AssertEqual(t, agg.Count(values), 3)
+	tests.AssertEqual(t, agg.CountDistinct(values), 2)
+	tests.AssertEqual(t, agg.Count(int64s(nil, nil)), 0)
+	tests.AssertEqual(t, agg.CountDistinct(int64s()), 0)
+}
+
+func TestCountDistinctNormalized(t *testing.T) {
+	instant := time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	times := []nullable.Time{
+		nullable.NewTimeValue(instant),
+		nullable.NewTimeValue(instant.In(jakarta)),
+		nullable.NewTimeValue(time.Now()),
+		nullable.NewTime(nil),
+	}
+	// NewTimeValue keeps the monotonic reading of time.Now, so compare with a stripped copy
+	now := times[2].MustGet().Round(0)
+	times = append(times, nullable.NewTimeValue(now))
+	tests.AssertEqual(t, agg.CountDistinct(times), 2)
+
+	floats := []nullable.Float64{
+		nullable.NewFloat64Value(math.NaN()),
+		nullable.NewFloat64Value(math.NaN()),
+		nullable.NewFloat64Value(0),
+		nullable.NewFloat64Value(math.Copysign(0, -1)),
+	}
+	tests.AssertEqual(t, agg.CountDistinct(floats), 2)
+
+	float32s := []nullable.Float32{nullable.NewFloat32Value(float32(math.NaN())), nullable.NewFloat32Value(float32(math.NaN()))}
+	tests.AssertEqual(t, agg.CountDistinct(float32s), 1)
+}
+
+func TestCoalesce(t *testing.T) {
+	values := int64s(nil, 3, nil, 5, nil)
+	tests.AssertEqual(t, agg.First(values), nullable.NewInt64Value(3))
+	tests.AssertEqual(t, agg.Last(values), nullable.NewInt64Value(5))
+	tests.AssertEqual(t, agg.Coalesce(values...), nullable.NewInt64Value(3))
+	tests.AssertEqual(t, agg.Coalesce(nullable.NewInt64(nil), nullable.NewInt64Value(9)), nullable.NewInt64Value(9))
+	tests.AssertEqual(t, agg.First(int64s(nil)).IsNull(), true)
+	tests.AssertEqual(t, agg.Last(int64s()).IsNull(), true)
+}
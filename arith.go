@@ -0,0 +1,111 @@
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// DivisionPolicy decides what Div of numeric types returns when the divisor is zero
+type DivisionPolicy int32
+
+const (
+	// DivisionNull returns NULL like MySQL does, this is the default
+	DivisionNull DivisionPolicy = iota
+	// DivisionError returns ErrDivisionByZero like PostgreSQL does
+	DivisionError
+)
+
+var divisionPolicy int32
+
+// ErrDivisionByZero is returned by Div when the divisor is zero and DivisionError is chosen
+var ErrDivisionByZero = errors.New("nullable: division by zero")
+
+// SetDivisionPolicy changes what Div of every numeric type returns when the divisor is zero
+func SetDivisionPolicy(policy DivisionPolicy) {
+	atomic.StoreInt32(&divisionPolicy, int32(policy))
+}
+
+func currentDivisionPolicy() DivisionPolicy {
+	return DivisionPolicy(atomic.LoadInt32(&divisionPolicy))
+}
+
+// divisionByZero applies current policy, the result is NULL either way
+func divisionByZero() error {
+	if currentDivisionPolicy() == DivisionError {
+		return ErrDivisionByZero
+	}
+	return nil
+}
+
+type signedInteger interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsignedInteger interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// operation describes overflowed arithmetic as Value of *OverflowError
+type operation struct {
+	operator string
+	left     interface{}
+	right    interface{}
+}
+
+func (o operation) String() string {
+	if o.right == nil {
+		return fmt.Sprintf("%s(%v)", o.operator, o.left)
+	}
+	return fmt.Sprintf("%v %s %v", o.left, o.operator, o.right)
+}
+
+// isMinSigned reports whether value is the minimum of its type, which can't be negated
+func isMinSigned[T signedInteger](value T) bool {
+	return value < 0 && -value < 0
+}
+
+// The checked operations return the wrapped around result and whether it overflowed
+
+func addSigned[T signedInteger](a, b T) (T, bool) {
+	sum := a + b
+	return sum, (b > 0 && sum < a) || (b < 0 && sum > a)
+}
+
+func subSigned[T signedInteger](a, b T) (T, bool) {
+	difference := a - b
+	return difference, (b > 0 && difference > a) || (b < 0 && difference < a)
+}
+
+func mulSigned[T signedInteger](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, false
+	}
+	product := a * b
+	if (a == -1 && isMinSigned(b)) || (b == -1 && isMinSigned(a)) {
+		return product, true
+	}
+	return product, product/b != a
+}
+
+// divSigned expects nonzero divisor, only the minimum divided by -1 overflows
+func divSigned[T signedInteger](a, b T) (T, bool) {
+	if b == -1 && isMinSigned(a) {
+		return a, true
+	}
+	return a / b, false
+}
+
+func addUnsigned[T unsignedInteger](a, b T) (T, bool) {
+	sum := a + b
+	return sum, sum < a
+}
+
+func subUnsigned[T unsignedInteger](a, b T) (T, bool) {
+	return a - b, b > a
+}
+
+func mulUnsigned[T unsignedInteger](a, b T) (T, bool) {
+	product := a * b
+	return product, a != 0 && product/a != b
+}
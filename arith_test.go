@@ -0,0 +1,39 @@
+package nullable
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCheckedOperations(t *testing.T) {
+	// Every pair of 8-bit operands against exact results in wider integers
+	for a := math.MinInt8; a <= math.MaxInt8; a++ {
+		for b := math.MinInt8; b <= math.MaxInt8; b++ {
+			assertChecked(t, "+", a, b, a+b, math.MinInt8, math.MaxInt8, addSigned[int8])
+			assertChecked(t, "-", a, b, a-b, math.MinInt8, math.MaxInt8, subSigned[int8])
+			assertChecked(t, "*", a, b, a*b, math.MinInt8, math.MaxInt8, mulSigned[int8])
+			if b != 0 {
+				assertChecked(t, "/", a, b, a/b, math.MinInt8, math.MaxInt8, divSigned[int8])
+			}
+		}
+	}
+
+	for a := 0; a <= math.MaxUint8; a++ {
+		for b := 0; b <= math.MaxUint8; b++ {
+			assertChecked(t, "+", a, b, a+b, 0, math.MaxUint8, addUnsigned[uint8])
+			assertChecked(t, "-", a, b, a-b, 0, math.MaxUint8, subUnsigned[uint8])
+			assertChecked(t, "*", a, b, a*b, 0, math.MaxUint8, mulUnsigned[uint8])
+		}
+	}
+}
+
+func assertChecked[T int8 | uint8](t *testing.T, operator string, a, b, exact, min, max int, fn func(a, b T) (T, bool)) {
+	t.Helper()
+	result, isOverflow := fn(T(a), T(b))
+	if expected := exact < min || exact > max; isOverflow != expected {
+		t.Fatalf("Expected overflow of %d %s %d to be %v", a, operator, b, expected)
+	}
+	if !isOverflow && int(result) != exact {
+		t.Fatalf("Expected %d %s %d to be %d, got %d", a, operator, b, exact, result)
+	}
+}
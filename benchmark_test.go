@@ -0,0 +1,216 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+)
+
+type benchmarkNullable struct {
+	ID      nullable.Int64
+	Name    nullable.String
+	Score   nullable.Float64
+	Active  nullable.Bool
+	Created nullable.Time
+}
+
+type benchmarkSQLNull struct {
+	ID      sql.NullInt64
+	Name    sql.NullString
+	Score   sql.NullFloat64
+	Active  sql.NullBool
+	Created sql.NullTime
+}
+
+type benchmarkPointer struct {
+	ID      *int64
+	Name    *string
+	Score   *float64
+	Active  *bool
+	Created *time.Time
+}
+
+var (
+	benchmarkID      int64 = 9007199254740993
+	benchmarkName          = "Athaariq Ardhiansyah"
+	benchmarkScore         = 97.5
+	benchmarkActive        = true
+	benchmarkCreated       = time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+)
+
+func BenchmarkMarshalJSONNullable(b *testing.B) {
+	row := benchmarkNullable{
+		ID:      nullable.NewInt64(&benchmarkID),
+		Name:    nullable.NewString(&benchmarkName),
+		Score:   nullable.NewFloat64(&benchmarkScore),
+		Active:  nullable.NewBool(&benchmarkActive),
+		Created: nullable.NewTime(&benchmarkCreated),
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		json.Marshal(row)
+	}
+}
+
+func BenchmarkMarshalJSONSQLNull(b *testing.B) {
+	row := benchmarkSQLNull{
+		ID:      sql.NullInt64{Int64: benchmarkID, Valid: true},
+		Name:    sql.NullString{String: benchmarkName, Valid: true},
+		Score:   sql.NullFloat64{Float64: benchmarkScore, Valid: true},
+		Active:  sql.NullBool{Bool: benchmarkActive, Valid: true},
+		Created: sql.NullTime{Time: benchmarkCreated, Valid: true},
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		json.Marshal(row)
+	}
+}
+
+func BenchmarkMarshalJSONPointer(b *testing.B) {
+	row := benchmarkPointer{
+		ID:      &benchmarkID,
+		Name:    &benchmarkName,
+		Score:   &benchmarkScore,
+		Active:  &benchmarkActive,
+		Created: &benchmarkCreated,
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		json.Marshal(row)
+	}
+}
+
+func BenchmarkAppendJSON(b *testing.B) {
+	row := benchmarkNullable{
+		ID:      nullable.NewInt64(&benchmarkID),
+		Name:    nullable.NewString(&benchmarkName),
+		Score:   nullable.NewFloat64(&benchmarkScore),
+		Active:  nullable.NewBool(&benchmarkActive),
+		Created: nullable.NewTime(&benchmarkCreated),
+	}
+	buffer := make([]byte, 0, 256)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buffer = row.ID.AppendJSON(buffer[:0])
+		buffer = row.Name.AppendJSON(buffer)
+		buffer = row.Score.AppendJSON(buffer)
+		buffer = row.Active.AppendJSON(buffer)
+		buffer = row.Created.AppendJSON(buffer)
+	}
+}
+
+func BenchmarkUnmarshalJSONNullable(b *testing.B) {
+	data := []byte(`{"ID":9007199254740993,"Name":"Athaariq Ardhiansyah","Score":97.5,"Active":true,"Created":"2021-09-06T10:00:00Z"}`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var row benchmarkNullable
+		json.Unmarshal(data, &row)
+	}
+}
+
+func BenchmarkUnmarshalJSONSQLNull(b *testing.B) {
+	data := []byte(`{"ID":{"Int64":9007199254740993,"Valid":true},"Name":{"String":"Athaariq Ardhiansyah","Valid":true},"Score":{"Float64":97.5,"Valid":true},"Active":{"Bool":true,"Valid":true},"Created":{"Time":"2021-09-06T10:00:00Z","Valid":true}}`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var row benchmarkSQLNull
+		json.Unmarshal(data, &row)
+	}
+}
+
+func BenchmarkUnmarshalJSONPointer(b *testing.B) {
+	data := []byte(`{"ID":9007199254740993,"Name":"Athaariq Ardhiansyah","Score":97.5,"Active":true,"Created":"2021-09-06T10:00:00Z"}`)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var row benchmarkPointer
+		json.Unmarshal(data, &row)
+	}
+}
+
+// benchmarkScanSources are the values which drivers commonly return
+var benchmarkScanSources = []struct {
+	name  string
+	value interface{}
+}{
+	{"int64", int64(9007199254740993)},
+	{"bytes", []byte("9007199254740993")},
+}
+
+func BenchmarkScanNullable(b *testing.B) {
+	for _, source := range benchmarkScanSources {
+		b.Run("Int64/"+source.name, func(b *testing.B) {
+			var target nullable.Int64
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				target.Scan(source.value)
+			}
+		})
+		b.Run("Uint64/"+source.name, func(b *testing.B) {
+			var target nullable.Uint64
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				target.Scan(source.value)
+			}
+		})
+		b.Run("Float64/"+source.name, func(b *testing.B) {
+			var target nullable.Float64
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				target.Scan(source.value)
+			}
+		})
+	}
+
+	b.Run("String/bytes", func(b *testing.B) {
+		var target nullable.String
+		value := []byte(benchmarkName)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			target.Scan(value)
+		}
+	})
+	b.Run("Time/time", func(b *testing.B) {
+		var target nullable.Time
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			target.Scan(benchmarkCreated)
+		}
+	})
+}
+
+func BenchmarkScanSQLNull(b *testing.B) {
+	for _, source := range benchmarkScanSources {
+		b.Run("Int64/"+source.name, func(b *testing.B) {
+			var target sql.NullInt64
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				target.Scan(source.value)
+			}
+		})
+		b.Run("Float64/"+source.name, func(b *testing.B) {
+			var target sql.NullFloat64
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				target.Scan(source.value)
+			}
+		})
+	}
+
+	b.Run("String/bytes", func(b *testing.B) {
+		var target sql.NullString
+		value := []byte(benchmarkName)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			target.Scan(value)
+		}
+	})
+	b.Run("Time/time", func(b *testing.B) {
+		var target sql.NullTime
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			target.Scan(benchmarkCreated)
+		}
+	})
+}
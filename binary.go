@@ -0,0 +1,102 @@
+package nullable
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// binaryVersion is the first byte of every MarshalBinary output.
+// Bump it whenever the layout of any type is changed.
+//
+// Layout: [version] [validity] [payload...]
+// Validity is 0 for NULL (no payload follows) or 1 for a valid value.
+const binaryVersion byte = 1
+
+var errBinaryTrailing = errors.New("unexpected trailing bytes")
+
+// newBinary creates the header of binary encoding and reserves the payload size
+func newBinary(isValid bool, payloadSize int) []byte {
+	buffer := make([]byte, 2, 2+payloadSize)
+	buffer[0] = binaryVersion
+	if isValid {
+		buffer[1] = 1
+	}
+	return buffer
+}
+
+// readBinary validates the header and returns the payload
+func readBinary(data []byte, target string) (payload []byte, isValid bool, err error) {
+	if len(data) < 2 {
+		return nil, false, fmt.Errorf("decoding binary %s: data too short", target)
+	}
+	if data[0] != binaryVersion {
+		return nil, false, fmt.Errorf("decoding binary %s: unsupported version %d", target, data[0])
+	}
+	switch data[1] {
+	case 0:
+		if len(data) > 2 {
+			return nil, false, fmt.Errorf("decoding binary %s: %v", target, errBinaryTrailing)
+		}
+		return nil, false, nil
+	case 1:
+		return data[2:], true, nil
+	}
+	return nil, false, fmt.Errorf("decoding binary %s: invalid validity byte %d", target, data[1])
+}
+
+// appendVarint appends zig-zag encoded signed integer
+func appendVarint(buffer []byte, value int64) []byte {
+	var encoded [binary.MaxVarintLen64]byte
+	size := binary.PutVarint(encoded[:], value)
+	return append(buffer, encoded[:size]...)
+}
+
+// appendUvarint appends unsigned integer
+func appendUvarint(buffer []byte, value uint64) []byte {
+	var encoded [binary.MaxVarintLen64]byte
+	size := binary.PutUvarint(encoded[:], value)
+	return append(buffer, encoded[:size]...)
+}
+
+// readVarint decodes a whole payload as signed integer that fits into given bits
+func readVarint(payload []byte, bits int, target string) (int64, error) {
+	value, size := binary.Varint(payload)
+	if size <= 0 {
+		return 0, fmt.Errorf("decoding binary %s: malformed varint", target)
+	}
+	if size != len(payload) {
+		return 0, fmt.Errorf("decoding binary %s: %v", target, errBinaryTrailing)
+	}
+	if bits < 64 {
+		limit := int64(1) << (bits - 1)
+		if value < -limit || value >= limit {
+			return 0, fmt.Errorf("decoding binary %s: value %d out of range", target, value)
+		}
+	}
+	return value, nil
+}
+
+// readUvarint decodes a whole payload as unsigned integer that fits into given bits
+func readUvarint(payload []byte, bits int, target string) (uint64, error) {
+	value, size := binary.Uvarint(payload)
+	if size <= 0 {
+		return 0, fmt.Errorf("decoding binary %s: malformed uvarint", target)
+	}
+	if size != len(payload) {
+		return 0, fmt.Errorf("decoding binary %s: %v", target, errBinaryTrailing)
+	}
+	if bits < 64 && value > math.MaxUint64>>(64-bits) {
+		return 0, fmt.Errorf("decoding binary %s: value %d out of range", target, value)
+	}
+	return value, nil
+}
+
+// readFixed makes sure the payload has exactly given size
+func readFixed(payload []byte, size int, target string) error {
+	if len(payload) != size {
+		return fmt.Errorf("decoding binary %s: expected %d bytes of payload, got %d", target, size, len(payload))
+	}
+	return nil
+}
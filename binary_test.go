@@ -0,0 +1,113 @@
+package nullable_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func marshalUnmarshalBinary(t *testing.T, target interface{}) {
+	serialized, err := target.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Failed to marshal %T to binary because: %s", target, err)
+		return
+	}
+
+	unserialized := reflect.New(reflect.TypeOf(target))
+	if err := unserialized.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(serialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T from binary because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized.Elem().Interface(), target)
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(target); err != nil {
+		t.Fatalf("Failed to encode %T with gob because: %s", target, err)
+		return
+	}
+
+	decoded := reflect.New(reflect.TypeOf(target))
+	if err := gob.NewDecoder(&buffer).Decode(decoded.Interface()); err != nil {
+		t.Fatalf("Failed to decode %T with gob because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, decoded.Elem().Interface(), target)
+}
+
+func TestBinaryLayout(t *testing.T) {
+	var basicInt int64 = -1
+	serialized, _ := nullable.NewInt64(&basicInt).MarshalBinary()
+	tests.AssertEqual(t, serialized, []byte{1, 1, 1})
+
+	serialized, _ = nullable.NewInt64(nil).MarshalBinary()
+	tests.AssertEqual(t, serialized, []byte{1, 0})
+
+	var basicFloat float32 = 1
+	serialized, _ = nullable.NewFloat32(&basicFloat).MarshalBinary()
+	tests.AssertEqual(t, serialized, []byte{1, 1, 0x3f, 0x80, 0, 0})
+}
+
+func TestBinaryGobStruct(t *testing.T) {
+	type CachedUser struct {
+		ID       uint64
+		Nickname nullable.String
+		Age      nullable.Uint8
+		Balance  nullable.Int64
+	}
+
+	nickname := "thor"
+	var balance int64 = -1234
+	user := CachedUser{
+		ID:       7,
+		Nickname: nullable.NewString(&nickname),
+		Age:      nullable.NewUint8(nil),
+		Balance:  nullable.NewInt64(&balance),
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(user); err != nil {
+		t.Fatalf("Failed to encode struct with gob because: %s", err)
+	}
+
+	var result CachedUser
+	if err := gob.NewDecoder(&buffer).Decode(&result); err != nil {
+		t.Fatalf("Failed to decode struct with gob because: %s", err)
+	}
+	tests.AssertEqual(t, result, user)
+}
+
+func TestBinaryInvalid(t *testing.T) {
+	var nullableInt8 nullable.Int8
+	var nullableInt16 nullable.Int16
+	var nullableUint32 nullable.Uint32
+	var nullableBool nullable.Bool
+	var nullableFloat64 nullable.Float64
+
+	invalids := []struct {
+		target encoding.BinaryUnmarshaler
+		data   []byte
+	}{
+		{&nullableInt8, []byte{}},
+		{&nullableInt8, []byte{1}},
+		{&nullableInt8, []byte{2, 1, 0}},
+		{&nullableInt8, []byte{1, 2, 0}},
+		{&nullableInt8, []byte{1, 0, 0}},
+		{&nullableInt8, []byte{1, 1, 0, 0}},
+		{&nullableInt16, []byte{1, 1, 0x80, 0x80, 0x04}},
+		{&nullableInt16, []byte{1, 1, 0x80}},
+		{&nullableUint32, []byte{1, 1, 0x80, 0x80, 0x80, 0x80, 0x10}},
+		{&nullableBool, []byte{1, 1, 2}},
+		{&nullableFloat64, []byte{1, 1, 0, 0, 0, 0}},
+	}
+
+	for _, invalid := range invalids {
+		if err := invalid.target.UnmarshalBinary(invalid.data); err == nil {
+			t.Errorf("Expected error while unmarshalling %v into %T", invalid.data, invalid.target)
+		}
+	}
+}
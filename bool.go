@@ -1,8 +1,14 @@
 package nullable
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +34,14 @@ func NewBool(value *bool) Bool {
 	}
 }
 
+// NewBoolValue creates a new valid nullable boolean from plain value
+func NewBoolValue(value bool) Bool {
+	return Bool{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or boolean
 func (n Bool) Get() *bool {
 	if !n.isValid {
@@ -46,38 +60,344 @@ func (n *Bool) Set(value *bool) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Bool) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Bool) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Bool) ValueOr(fallback bool) bool {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or false when NULL
+func (n Bool) ValueOrZero() bool {
+	return n.ValueOr(false)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Bool) MustGet() bool {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Bool")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Bool) Clone() Bool {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Bool) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatBool(n.realValue)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Bool) GoString() string {
+	if !n.isValid {
+		return "nullable.NewBool(nil)"
+	}
+	return fmt.Sprintf("nullable.NewBoolValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Bool) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Bool) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.BoolValue(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Bool) Map(fn func(bool) bool) Bool {
+	if !n.isValid {
+		return n
+	}
+	return NewBoolValue(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Bool) FlatMap(fn func(bool) Bool) Bool {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Bool) Filter(fn func(bool) bool) Bool {
+	if !n.isValid || !fn(n.realValue) {
+		return NewBool(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes, false is before true
+func (n Bool) Compare(other Bool, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	switch {
+	case n.realValue == other.realValue:
+		return 0
+	case other.realValue:
+		return -1
+	}
+	return 1
+}
+
+// Equal returns true when both are NULL, or both hold the same value, see EqualSQL for SQL "="
+func (n Bool) Equal(other Bool) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// IsTrue returns true only when current value is true, like SQL "IS TRUE"
+func (n Bool) IsTrue() bool {
+	return n.isValid && n.realValue
+}
+
+// IsFalse returns true only when current value is false, like SQL "IS FALSE"
+func (n Bool) IsFalse() bool {
+	return n.isValid && !n.realValue
+}
+
+// IsUnknown returns true when current value is NULL, like SQL "IS UNKNOWN"
+func (n Bool) IsUnknown() bool {
+	return !n.isValid
+}
+
+// And follows SQL three-valued logic, false wins over NULL
+func (n Bool) And(other Bool) Bool {
+	if n.IsFalse() || other.IsFalse() {
+		return NewBoolValue(false)
+	}
+	if !n.isValid || !other.isValid {
+		return NewBool(nil)
+	}
+	return NewBoolValue(true)
+}
+
+// Or follows SQL three-valued logic, true wins over NULL
+func (n Bool) Or(other Bool) Bool {
+	if n.IsTrue() || other.IsTrue() {
+		return NewBoolValue(true)
+	}
+	if !n.isValid || !other.isValid {
+		return NewBool(nil)
+	}
+	return NewBoolValue(false)
+}
+
+// Not follows SQL three-valued logic, NULL stays NULL
+func (n Bool) Not() Bool {
+	if !n.isValid {
+		return n
+	}
+	return NewBoolValue(!n.realValue)
+}
+
+// Xor follows SQL three-valued logic, NULL when either is NULL
+func (n Bool) Xor(other Bool) Bool {
+	if !n.isValid || !other.isValid {
+		return NewBool(nil)
+	}
+	return NewBoolValue(n.realValue != other.realValue)
+}
+
+// Implies is "NOT n OR other" in SQL three-valued logic
+func (n Bool) Implies(other Bool) Bool {
+	return n.Not().Or(other)
+}
+
+// EqualSQL follows SQL "=" operator, NULL when either is NULL, see Equal for plain comparison
+func (n Bool) EqualSQL(other Bool) Bool {
+	if !n.isValid || !other.isValid {
+		return NewBool(nil)
+	}
+	return NewBoolValue(n.realValue == other.realValue)
+}
+
 // MarshalJSON converts current value to JSON
 func (n Bool) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Bool) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendBool(dst, n.realValue)
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Bool) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Bool) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = false
 		return nil
 	}
 
+	if isLenientJSON() {
+		return n.readLenientJSON(data)
+	}
+
 	var parsed bool
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	switch string(data) {
+	case "true":
+		parsed = true
+	case "false":
+	default:
+		var slow bool
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// readLenientJSON is readLenientJSON which also accepts "yes" and "no", only for JSON
+func (n *Bool) readLenientJSON(data []byte) error {
+	value, err := decodeLenientJSON(data)
+	if err != nil {
+		return err
+	}
+	if text, isText := value.(string); isText {
+		switch {
+		case strings.EqualFold(text, "yes"):
+			value = true
+		case strings.EqualFold(text, "no"):
+			value = false
+		}
+	}
+	return scanLenientJSON(n, value)
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Bool) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendBool(nil, n.realValue), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Bool) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Bool) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Bool) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Bool) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, 1)
+	if n.isValid {
+		if n.realValue {
+			buffer = append(buffer, 1)
+		} else {
+			buffer = append(buffer, 0)
+		}
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Bool) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Bool")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	if err := readFixed(payload, 1, "Bool"); err != nil {
 		return err
 	}
+	if payload[0] > 1 {
+		return fmt.Errorf("decoding binary Bool: invalid value %d", payload[0])
+	}
+	parsed := payload[0] == 1
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Bool) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Bool) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Bool) Scan(value interface{}) error {
 	if value == nil {
 		n.realValue, n.isValid = false, false
 		return nil
 	}
+
+	parsed, err := scanBool(value, n)
+	if err != nil {
+		return err
+	}
+	n.realValue = parsed
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -88,6 +408,26 @@ func (n Bool) Value() (driver.Value, error) {
 	return n.realValue, nil
 }
 
+// FromSQLNullBool converts sql.NullBool to nullable boolean
+func FromSQLNullBool(value sql.NullBool) Bool {
+	if !value.Valid {
+		return NewBool(nil)
+	}
+	return NewBoolValue(value.Bool)
+}
+
+// SQLNull converts to sql.NullBool
+func (n Bool) SQLNull() sql.NullBool {
+	return sql.NullBool{Bool: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Bool) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": []string{"boolean", "null"},
+	}
+}
+
 // GormDataType gorm common data type
 func (Bool) GormDataType() string {
 	return "bool_null"
@@ -1,6 +1,9 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"fmt"
+	"log/slog"
 	"testing"
 
 	"gorm.io/gorm/utils/tests"
@@ -17,6 +20,11 @@ func TestScanBool(t *testing.T) {
 	nullableBool.Scan(false)
 	tests.AssertEqual(t, nullableBool.Get(), false)
 
+	// "yes" and "no" are only for lenient JSON, database/sql doesn't accept them
+	if err := nullableBool.Scan("yes"); err == nil {
+		t.Error("Expected error while scanning yes")
+	}
+
 	nullableBool.Scan(nil)
 	tests.AssertEqual(t, nullableBool.Get(), nil)
 }
@@ -60,6 +68,151 @@ func TestJSONBool(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewBool(nil))
 }
 
+func TestGQLBool(t *testing.T) {
+	trueBool := true
+	marshalUnmarshalGQL(t, nullable.NewBool(&trueBool))
+
+	falseBool := false
+	marshalUnmarshalGQL(t, nullable.NewBool(&falseBool))
+
+	marshalUnmarshalGQL(t, nullable.NewBool(nil))
+}
+
+func TestTextBool(t *testing.T) {
+	trueBool := true
+	marshalUnmarshalText(t, nullable.NewBool(&trueBool))
+
+	falseBool := false
+	marshalUnmarshalText(t, nullable.NewBool(&falseBool))
+
+	marshalUnmarshalText(t, nullable.NewBool(nil))
+}
+
+func TestBinaryBool(t *testing.T) {
+	trueBool := true
+	marshalUnmarshalBinary(t, nullable.NewBool(&trueBool))
+
+	falseBool := false
+	marshalUnmarshalBinary(t, nullable.NewBool(&falseBool))
+
+	marshalUnmarshalBinary(t, nullable.NewBool(nil))
+}
+
+func TestAccessorBool(t *testing.T) {
+	nullableValue := nullable.NewBoolValue(true)
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(false), true)
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), true)
+	tests.AssertEqual(t, nullableValue.MustGet(), true)
+	tests.AssertEqual(t, nullableValue.Map(func(v bool) bool { return !v }).MustGet(), false)
+	tests.AssertEqual(t, nullableValue.FlatMap(func(bool) nullable.Bool { return nullable.NewBool(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(bool) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(bool) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Bool](true), nullableValue)
+
+	nullValue := nullable.NewBool(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(false), false)
+	tests.AssertEqual(t, nullValue.ValueOrZero(), false)
+	tests.AssertEqual(t, nullValue.Map(func(v bool) bool { return !v }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(bool) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullBool(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullBool(sql.NullBool{Bool: true, Valid: true}).Get(), true)
+	tests.AssertEqual(t, nullable.FromSQLNullBool(sql.NullBool{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewBoolValue(true).SQLNull(), sql.NullBool{Bool: true, Valid: true})
+	tests.AssertEqual(t, nullable.NewBool(nil).SQLNull(), sql.NullBool{})
+}
+
+func TestLogicBool(t *testing.T) {
+	values := map[string]nullable.Bool{
+		"T": nullable.NewBoolValue(true),
+		"F": nullable.NewBoolValue(false),
+		"N": nullable.NewBool(nil),
+	}
+	name := func(value nullable.Bool) string {
+		switch {
+		case value.IsTrue():
+			return "T"
+		case value.IsFalse():
+			return "F"
+		}
+		return "N"
+	}
+
+	// Operands are TT, TF, TN, FT, FF, FN, NT, NF, NN
+	operands := []string{"TT", "TF", "TN", "FT", "FF", "FN", "NT", "NF", "NN"}
+	truthTables := []struct {
+		operator string
+		fn       func(a, b nullable.Bool) nullable.Bool
+		results  string
+	}{
+		{"AND", nullable.Bool.And, "TFNFFFNFN"},
+		{"OR", nullable.Bool.Or, "TTTTFNTNN"},
+		{"XOR", nullable.Bool.Xor, "FTNTFNNNN"},
+		{"IMPLIES", nullable.Bool.Implies, "TFNTTTTNN"},
+		{"=", nullable.Bool.EqualSQL, "TFNFTNNNN"},
+	}
+
+	for _, table := range truthTables {
+		for i, operand := range operands {
+			a, b := values[operand[:1]], values[operand[1:]]
+			if result := name(table.fn(a, b)); result != table.results[i:i+1] {
+				t.Errorf("Expected %s %s %s to be %s, got %s", operand[:1], table.operator, operand[1:], table.results[i:i+1], result)
+			}
+		}
+	}
+
+	tests.AssertEqual(t, name(values["T"].Not()), "F")
+	tests.AssertEqual(t, name(values["F"].Not()), "T")
+	tests.AssertEqual(t, name(values["N"].Not()), "N")
+	tests.AssertEqual(t, values["N"].IsUnknown(), true)
+	tests.AssertEqual(t, values["F"].IsUnknown(), false)
+}
+
+func TestCompareBool(t *testing.T) {
+	low, high := nullable.NewBoolValue(false), nullable.NewBoolValue(true)
+	null := nullable.NewBool(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewBoolValue(false), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewBool(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewBoolValue(false)), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewBool(nil)), true)
+}
+
+func TestFormatBool(t *testing.T) {
+	value := nullable.NewBoolValue(true)
+	null := nullable.NewBool(nil)
+	tests.AssertEqual(t, value.String(), "true")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `true|"true"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "true")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewBoolValue(true)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewBool(nil)")
+}
+
+func TestLogValueBool(t *testing.T) {
+	value := nullable.NewBoolValue(true).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindBool)
+	tests.AssertEqual(t, value.Bool(), true)
+
+	null := nullable.NewBool(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestBool(t *testing.T) {
 	type TestNullableBool struct {
 		ID      uint
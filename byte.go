@@ -1,13 +1,24 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
+var errEmptyByte = errors.New("empty value")
+
 // Byte SQL type that can retrieve NULL value
 type Byte struct {
 	realValue byte
@@ -28,6 +39,14 @@ func NewByte(value *byte) Byte {
 	}
 }
 
+// NewByteValue creates a new valid nullable single byte from plain value
+func NewByteValue(value byte) Byte {
+	return Byte{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or single byte
 func (n Byte) Get() *byte {
 	if !n.isValid {
@@ -46,30 +65,254 @@ func (n *Byte) Set(value *byte) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Byte) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Byte) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Byte) ValueOr(fallback byte) byte {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Byte) ValueOrZero() byte {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Byte) MustGet() byte {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Byte")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Byte) Clone() Byte {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Byte) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Byte) GoString() string {
+	if !n.isValid {
+		return "nullable.NewByte(nil)"
+	}
+	return fmt.Sprintf("nullable.NewByteValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Byte) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Byte) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Uint64Value(uint64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Byte) Map(fn func(byte) byte) Byte {
+	if !n.isValid {
+		return n
+	}
+	return NewByteValue(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Byte) FlatMap(fn func(byte) Byte) Byte {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Byte) Filter(fn func(byte) bool) Byte {
+	if !n.isValid || !fn(n.realValue) {
+		return NewByte(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Byte) Compare(other Byte, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Byte) Equal(other Byte) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
 // MarshalJSON converts current value to JSON
 func (n Byte) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Byte) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendUint(dst, uint64(n.realValue), 10)
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Byte) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Byte) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
 	var parsed byte
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if isLenientJSON() {
+		// Scan treats the value as raw bytes, but JSON input is a number
+		value, err := decodeLenientJSON(data)
+		if err != nil {
+			return err
+		}
+		if number, isNumber := value.(json.Number); isNumber {
+			value = string(number)
+		}
+		var scanned byte
+		if err := convertAssign(&scanned, value); err != nil {
+			return err
+		}
+		parsed = scanned
+	} else if fast, isFast := parseJSONUint(data, 8); isFast {
+		parsed = byte(fast)
+	} else {
+		var slow byte
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Byte) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Byte) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = byte(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Byte) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Byte) UnmarshalGQL(v interface{}) error {
+	switch v.(type) {
+	case nil, string, json.Number:
+		return readGQL(n, v)
+	}
+
+	// Scan treats the value as raw bytes, but GraphQL input is a number
+	var parsed byte
+	if err := convertAssign(&parsed, v); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Byte) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, 1)
+	if n.isValid {
+		buffer = append(buffer, byte(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Byte) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Byte")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	if err := readFixed(payload, 1, "Byte"); err != nil {
 		return err
 	}
+	parsed := byte(payload[0])
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Byte) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Byte) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Byte) Scan(value interface{}) error {
 	if value == nil {
@@ -77,13 +320,26 @@ func (n *Byte) Scan(value interface{}) error {
 		return nil
 	}
 
-	switch value.(type) {
+	switch typed := value.(type) {
 	case int:
-		n.realValue = byte(value.(int))
+		n.realValue = byte(typed)
+	case []byte:
+		if len(typed) == 0 {
+			return newScanError(value, n, errEmptyByte)
+		}
+		n.realValue = typed[0]
+	case string:
+		if len(typed) == 0 {
+			return newScanError(value, n, errEmptyByte)
+		}
+		n.realValue = typed[0]
 	default:
 		var buffer []byte
 		if err := convertAssign(&buffer, value); err != nil {
-			return err
+			return newFallbackError(value, n, err)
+		}
+		if len(buffer) == 0 {
+			return newScanError(value, n, errEmptyByte)
 		}
 		n.realValue = buffer[0]
 	}
@@ -102,6 +358,41 @@ func (n Byte) Value() (driver.Value, error) {
 	}, nil
 }
 
+// FromSQLNullByte converts sql.NullByte to nullable single byte
+func FromSQLNullByte(value sql.NullByte) Byte {
+	if !value.Valid {
+		return NewByte(nil)
+	}
+	return NewByteValue(value.Byte)
+}
+
+// FromSQLNullInt64ToByte converts sql.NullInt64 to nullable single byte, fails with *OverflowError when out of range
+func FromSQLNullInt64ToByte(value sql.NullInt64) (Byte, error) {
+	if !value.Valid {
+		return NewByte(nil), nil
+	}
+	v := value.Int64
+	if v < 0 || int64(byte(v)) != v {
+		return NewByte(nil), overflowOf(v, new(Byte))
+	}
+	return NewByteValue(byte(v)), nil
+}
+
+// SQLNull converts to sql.NullByte
+func (n Byte) SQLNull() sql.NullByte {
+	return sql.NullByte{Byte: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Byte) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int32",
+		"minimum": 0,
+		"maximum": math.MaxUint8,
+	}
+}
+
 // GormDataType gorm common data type
 func (Byte) GormDataType() string {
 	return "byte_null"
@@ -119,3 +410,99 @@ func (Byte) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// ByteHex is Byte which is always written to JSON as two hex digits, like "ff"
+type ByteHex struct {
+	Byte
+}
+
+// NewByteHex creates a new nullable single byte which is written to JSON as hex digits
+func NewByteHex(value *byte) ByteHex {
+	return ByteHex{NewByte(value)}
+}
+
+// Clone returns a copy
+func (n ByteHex) Clone() ByteHex {
+	return ByteHex{n.Byte.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n ByteHex) GoString() string {
+	return "nullable.ByteHex{Byte: " + n.Byte.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Byte.Format
+func (n ByteHex) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string of two lowercase hex digits
+func (n ByteHex) MarshalJSON() ([]byte, error) {
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON string of two lowercase hex digits to dst without reflection
+func (n ByteHex) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return append(dst, '"', hexDigits[n.realValue>>4], hexDigits[n.realValue&0xf], '"')
+}
+
+// UnmarshalJSON writes JSON to this type, both number and hex text like "ff" or "0xFF" are accepted, failures are *DecodeError
+func (n *ByteHex) UnmarshalJSON(data []byte) error {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes number or hex text, the error is wrapped by UnmarshalJSON
+func (n *ByteHex) unmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		return n.Byte.unmarshalJSON(data)
+	}
+
+	var text string
+	if quoted, isFast := unquoteJSONFast(data); isFast {
+		text = string(quoted)
+	} else {
+		var slow string
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		text = slow
+	}
+	return n.setHex(text)
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n ByteHex) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen, strings are hex digits
+func (n *ByteHex) UnmarshalGQL(v interface{}) error {
+	if text, isText := v.(string); isText {
+		return n.setHex(text)
+	}
+	return n.Byte.UnmarshalGQL(v)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (ByteHex) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"string", "null"},
+		"pattern": "^[0-9a-f]{2}$",
+	}
+}
+
+// setHex parses hex digits with optional 0x prefix
+func (n *ByteHex) setHex(text string) error {
+	digits := strings.TrimPrefix(strings.TrimPrefix(text, "0x"), "0X")
+	parsed, err := strconv.ParseUint(digits, 16, 8)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = byte(parsed)
+	return nil
+}
@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -73,6 +78,160 @@ func TestJSONByte(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewByte(nil))
 }
 
+func TestHexByte(t *testing.T) {
+	var basicByte byte = 0xaf
+
+	serialized, _ := json.Marshal(nullable.NewByteHex(&basicByte))
+	tests.AssertEqual(t, string(serialized), `"af"`)
+
+	serialized, _ = json.Marshal(nullable.NewByteHex(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	inputs := []string{`"af"`, `"AF"`, `"0xaf"`, `175`}
+	for _, input := range inputs {
+		var decoded nullable.ByteHex
+		if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, decoded.Get(), basicByte)
+	}
+
+	var fromGQL nullable.ByteHex
+	fromGQL.UnmarshalGQL("0A")
+	tests.AssertEqual(t, fromGQL.Get(), byte(0x0a))
+
+	var invalid nullable.ByteHex
+	if err := json.Unmarshal([]byte(`"1ff"`), &invalid); err == nil {
+		t.Error("Expected error while unmarshalling hex out of range")
+	}
+}
+
+func TestGQLByte(t *testing.T) {
+	basicByte1 := byte(0)
+	marshalUnmarshalGQL(t, nullable.NewByte(&basicByte1))
+
+	basicByte2 := byte(0x7f)
+	marshalUnmarshalGQL(t, nullable.NewByte(&basicByte2))
+
+	basicByte3 := byte(0xff)
+	marshalUnmarshalGQL(t, nullable.NewByte(&basicByte3))
+
+	marshalUnmarshalGQL(t, nullable.NewByte(nil))
+}
+
+func TestTextByte(t *testing.T) {
+	basicByte1 := byte(0)
+	marshalUnmarshalText(t, nullable.NewByte(&basicByte1))
+
+	basicByte2 := byte(0x7f)
+	marshalUnmarshalText(t, nullable.NewByte(&basicByte2))
+
+	basicByte3 := byte(0xff)
+	marshalUnmarshalText(t, nullable.NewByte(&basicByte3))
+
+	marshalUnmarshalText(t, nullable.NewByte(nil))
+}
+
+func TestBinaryByte(t *testing.T) {
+	basicByte1 := byte(0)
+	marshalUnmarshalBinary(t, nullable.NewByte(&basicByte1))
+
+	basicByte2 := byte(0x7f)
+	marshalUnmarshalBinary(t, nullable.NewByte(&basicByte2))
+
+	basicByte3 := byte(0xff)
+	marshalUnmarshalBinary(t, nullable.NewByte(&basicByte3))
+
+	marshalUnmarshalBinary(t, nullable.NewByte(nil))
+}
+
+func TestAccessorByte(t *testing.T) {
+	nullableValue := nullable.NewByteValue(byte(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(byte(1)), byte(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), byte(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), byte(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v byte) byte { return v + 1 }).MustGet(), byte(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(byte) nullable.Byte { return nullable.NewByte(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(byte) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(byte) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Byte](byte(37)), nullableValue)
+
+	nullValue := nullable.NewByte(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(byte(1)), byte(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), byte(0))
+	tests.AssertEqual(t, nullValue.Map(func(v byte) byte { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(byte) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullByte(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullByte(sql.NullByte{Byte: 37, Valid: true}).Get(), 37)
+	tests.AssertEqual(t, nullable.FromSQLNullByte(sql.NullByte{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewByteValue(37).SQLNull(), sql.NullByte{Byte: 37, Valid: true})
+	tests.AssertEqual(t, nullable.NewByte(nil).SQLNull(), sql.NullByte{})
+}
+
+func TestSQLNullInt64ToByte(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt64ToByte(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullInt64ToByte(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	for _, outOfRange := range []int64{256, -1} {
+		if _, err := nullable.FromSQLNullInt64ToByte(sql.NullInt64{Int64: outOfRange, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while converting %d to Byte, got %v", outOfRange, err)
+		}
+	}
+}
+
+func TestCompareByte(t *testing.T) {
+	low, high := nullable.NewByteValue(byte(3)), nullable.NewByteValue(byte(37))
+	null := nullable.NewByte(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewByteValue(byte(3)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewByte(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewByteValue(byte(3))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewByte(nil)), true)
+}
+
+func TestFormatByte(t *testing.T) {
+	value := nullable.NewByteValue(42)
+	null := nullable.NewByte(nil)
+	tests.AssertEqual(t, value.String(), "42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `42|"42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewByteValue(0x2a)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewByte(nil)")
+}
+
+func TestLogValueByte(t *testing.T) {
+	value := nullable.NewByteValue(42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindUint64)
+	tests.AssertEqual(t, value.Uint64(), uint64(42))
+
+	null := nullable.NewByte(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestByte(t *testing.T) {
 	type TestNullableByte struct {
 		ID   uint
@@ -1,14 +1,22 @@
 package nullable
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
-// Bytes SQL type that can retrieve NULL value
+// Bytes SQL type that can retrieve NULL value.
+// The array is copied in and out, so neither caller nor driver buffers are shared, see View for zero-copy access.
 type Bytes struct {
 	realValue []byte
 	isValid   bool
@@ -23,45 +31,247 @@ func NewBytes(value *[]byte) Bytes {
 		}
 	}
 	return Bytes{
-		realValue: *value,
+		realValue: cloneBytes(*value),
 		isValid:   true,
 	}
 }
 
-// Get either nil or array of bytes
+// NewBytesValue creates a new valid nullable array of bytes from plain value
+func NewBytesValue(value []byte) Bytes {
+	return Bytes{
+		realValue: cloneBytes(value),
+		isValid:   true,
+	}
+}
+
+// Get either nil or a copy of array of bytes
 func (n Bytes) Get() *[]byte {
 	if !n.isValid {
 		return nil
 	}
-	return &n.realValue
+	copied := cloneBytes(n.realValue)
+	return &copied
 }
 
-// Set either nil or array of bytes
+// Set either nil or a copy of array of bytes
 func (n *Bytes) Set(value *[]byte) {
 	n.isValid = (value != nil)
 	if n.isValid {
-		n.realValue = *value
+		n.realValue = cloneBytes(*value)
 	} else {
 		n.realValue = []byte{}
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Bytes) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Bytes) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns a copy of current value, or fallback when NULL
+func (n Bytes) ValueOr(fallback []byte) []byte {
+	if !n.isValid {
+		return fallback
+	}
+	return cloneBytes(n.realValue)
+}
+
+// ValueOrZero returns current value, or nil when NULL
+func (n Bytes) ValueOrZero() []byte {
+	return n.ValueOr(nil)
+}
+
+// MustGet returns a copy of current value, panics when NULL
+func (n Bytes) MustGet() []byte {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Bytes")
+	}
+	return cloneBytes(n.realValue)
+}
+
+// View returns current value without copying, nil when NULL.
+// For hot paths only: the result must not be modified, and is shared with every copy of n.
+func (n Bytes) View() []byte {
+	if !n.isValid {
+		return nil
+	}
+	return n.realValue
+}
+
+// Clone returns a copy which doesn't share the array
+func (n Bytes) Clone() Bytes {
+	n.realValue = cloneBytes(n.realValue)
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Bytes) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return fmt.Sprint(n.realValue)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Bytes) GoString() string {
+	if !n.isValid {
+		return "nullable.NewBytes(nil)"
+	}
+	return fmt.Sprintf("nullable.NewBytesValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Bytes) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null, the array is copied because handlers may run later
+func (n Bytes) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.AnyValue(cloneBytes(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Bytes) Map(fn func([]byte) []byte) Bytes {
+	if !n.isValid {
+		return n
+	}
+	return NewBytesValue(fn(cloneBytes(n.realValue)))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Bytes) FlatMap(fn func([]byte) Bytes) Bytes {
+	if !n.isValid {
+		return n
+	}
+	return fn(cloneBytes(n.realValue))
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Bytes) Filter(fn func([]byte) bool) Bytes {
+	if !n.isValid || !fn(cloneBytes(n.realValue)) {
+		return NewBytes(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Bytes) Compare(other Bytes, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return bytes.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Bytes) Equal(other Bytes) bool {
+	return n.isValid == other.isValid && (!n.isValid || bytes.Equal(n.realValue, other.realValue))
+}
+
+// MarshalJSON converts current value to JSON, see SetBytesJSON
 func (n Bytes) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.appendJSON(nil, currentBytesJSON()), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Bytes) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, currentBytesJSON())
+}
+
+// UnmarshalJSON writes JSON to this type, see SetBytesJSON, failures are *DecodeError
 func (n *Bytes) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data, currentBytesJSON()))
+}
+
+// appendJSON appends JSON string of given encoding
+func (n Bytes) appendJSON(dst []byte, mode BytesJSON) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	dst = append(dst, '"')
+	dst = appendEncodedBytes(dst, n.realValue, mode)
+	return append(dst, '"')
+}
+
+// unmarshalJSON reads JSON string, given encoding is tried first
+func (n *Bytes) unmarshalJSON(data []byte, mode BytesJSON) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = []byte{}
 		return nil
 	}
 
-	var parsed []byte
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	var text string
+	if quoted, isFast := unquoteJSONFast(data); isFast {
+		text = string(quoted)
+	} else {
+		var slow string
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		text = slow
+	}
+
+	parsed, err := decodeBytes(text, mode)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to base64 text, NULL becomes empty text
+// which UnmarshalText reads back as a valid empty array, not NULL
+func (n Bytes) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(n.realValue)))
+	base64.StdEncoding.Encode(encoded, n.realValue)
+	return encoded, nil
+}
+
+// UnmarshalText writes base64 text to this type, empty text is a valid empty array
+func (n *Bytes) UnmarshalText(text []byte) error {
+	parsed := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	size, err := base64.StdEncoding.Decode(parsed, text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed[:size]
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Bytes) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Bytes) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, currentBytesJSON())
+}
+
+// unmarshalGQL reads string with given encoding first, other input is handled by readGQL
+func (n *Bytes) unmarshalGQL(v interface{}, mode BytesJSON) error {
+	text, isText := v.(string)
+	if !isText {
+		return readGQL(n, v)
+	}
+
+	parsed, err := decodeBytes(text, mode)
+	if err != nil {
 		return err
 	}
 
@@ -70,22 +280,72 @@ func (n *Bytes) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalBinary converts current value to compact binary form
+func (n Bytes) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, len(n.realValue))
+	if n.isValid {
+		buffer = append(buffer, n.realValue...)
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Bytes) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Bytes")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = []byte{}
+		return nil
+	}
+
+	parsed := cloneBytes(payload)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder interface
+func (n Bytes) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Bytes) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Bytes) Scan(value interface{}) error {
 	if value == nil {
 		n.realValue, n.isValid = []byte{}, false
 		return nil
 	}
+
+	parsed, err := scanBytes(value, n)
+	if err != nil {
+		return err
+	}
+	n.realValue = parsed
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
-// Value implements the driver Valuer interface.
+// Value implements the driver Valuer interface, the driver gets a copy of current value
 func (n Bytes) Value() (driver.Value, error) {
 	if !n.isValid {
 		return nil, nil
 	}
-	return n.realValue, nil
+	return cloneBytes(n.realValue), nil
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Bytes) JSONSchema() map[string]interface{} {
+	return bytesSchema(currentBytesJSON())
 }
 
 // GormDataType gorm common data type
@@ -103,3 +363,185 @@ func (Bytes) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// appendEncodedBytes appends text of given encoding
+func appendEncodedBytes(dst, value []byte, mode BytesJSON) []byte {
+	start := len(dst)
+	switch mode {
+	case BytesAsBase64URL:
+		dst = append(dst, make([]byte, base64.RawURLEncoding.EncodedLen(len(value)))...)
+		base64.RawURLEncoding.Encode(dst[start:], value)
+	case BytesAsHex:
+		dst = append(dst, make([]byte, hex.EncodedLen(len(value)))...)
+		hex.Encode(dst[start:], value)
+	default:
+		dst = append(dst, make([]byte, base64.StdEncoding.EncodedLen(len(value)))...)
+		base64.StdEncoding.Encode(dst[start:], value)
+	}
+	return dst
+}
+
+// decodeBytes tries given encoding first, base64 also accepts the other base64 alphabet,
+// hex also accepts base64. Base64 never falls back to hex, because most hex text is
+// valid base64 too, so the fallback would never be reached for it.
+func decodeBytes(text string, preferred BytesJSON) ([]byte, error) {
+	fallbacks := []BytesJSON{BytesAsBase64, BytesAsBase64URL}
+	if preferred == BytesAsHex {
+		fallbacks = []BytesJSON{BytesAsHex, BytesAsBase64, BytesAsBase64URL}
+	}
+
+	decoded, firstErr := decodeBytesAs(text, preferred)
+	if firstErr == nil {
+		return decoded, nil
+	}
+	for _, mode := range fallbacks {
+		if decoded, err := decodeBytesAs(text, mode); err == nil {
+			return decoded, nil
+		}
+	}
+	return nil, firstErr
+}
+
+// decodeBytesAs converts text of given encoding into binary, base64 padding is optional
+func decodeBytesAs(text string, mode BytesJSON) ([]byte, error) {
+	switch mode {
+	case BytesAsBase64URL:
+		return base64.RawURLEncoding.DecodeString(strings.TrimRight(text, "="))
+	case BytesAsHex:
+		return hex.DecodeString(text)
+	}
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(text, "="))
+}
+
+// bytesSchema describes JSON string of given encoding
+func bytesSchema(mode BytesJSON) map[string]interface{} {
+	switch mode {
+	case BytesAsBase64URL:
+		return map[string]interface{}{
+			"type":            []string{"string", "null"},
+			"pattern":         "^[A-Za-z0-9_-]*$",
+			"contentEncoding": "base64url",
+		}
+	case BytesAsHex:
+		return map[string]interface{}{
+			"type":            []string{"string", "null"},
+			"pattern":         "^([0-9a-f]{2})*$",
+			"contentEncoding": "base16",
+		}
+	}
+	return map[string]interface{}{
+		"type":            []string{"string", "null"},
+		"format":          "byte",
+		"contentEncoding": "base64",
+	}
+}
+
+// BytesBase64URL is Bytes which is always written to JSON as URL-safe base64 without padding
+type BytesBase64URL struct {
+	Bytes
+}
+
+// NewBytesBase64URL creates a new nullable array of bytes which is written to JSON as URL-safe base64 without padding
+func NewBytesBase64URL(value *[]byte) BytesBase64URL {
+	return BytesBase64URL{NewBytes(value)}
+}
+
+// Clone returns a copy which doesn't share the array
+func (n BytesBase64URL) Clone() BytesBase64URL {
+	return BytesBase64URL{n.Bytes.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n BytesBase64URL) GoString() string {
+	return "nullable.BytesBase64URL{Bytes: " + n.Bytes.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Bytes.Format
+func (n BytesBase64URL) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string of URL-safe base64 without padding
+func (n BytesBase64URL) MarshalJSON() ([]byte, error) {
+	return n.appendJSON(nil, BytesAsBase64URL), nil
+}
+
+// AppendJSON appends JSON string of URL-safe base64 without padding to dst without reflection
+func (n BytesBase64URL) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, BytesAsBase64URL)
+}
+
+// UnmarshalJSON writes JSON to this type, URL-safe base64 without padding is tried first, failures are *DecodeError
+func (n *BytesBase64URL) UnmarshalJSON(data []byte) error {
+	return newDecodeError(n, data, n.unmarshalJSON(data, BytesAsBase64URL))
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n BytesBase64URL) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *BytesBase64URL) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, BytesAsBase64URL)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (BytesBase64URL) JSONSchema() map[string]interface{} {
+	return bytesSchema(BytesAsBase64URL)
+}
+
+// BytesHex is Bytes which is always written to JSON as lowercase hex digits
+type BytesHex struct {
+	Bytes
+}
+
+// NewBytesHex creates a new nullable array of bytes which is written to JSON as lowercase hex digits
+func NewBytesHex(value *[]byte) BytesHex {
+	return BytesHex{NewBytes(value)}
+}
+
+// Clone returns a copy which doesn't share the array
+func (n BytesHex) Clone() BytesHex {
+	return BytesHex{n.Bytes.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n BytesHex) GoString() string {
+	return "nullable.BytesHex{Bytes: " + n.Bytes.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Bytes.Format
+func (n BytesHex) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string of lowercase hex digits
+func (n BytesHex) MarshalJSON() ([]byte, error) {
+	return n.appendJSON(nil, BytesAsHex), nil
+}
+
+// AppendJSON appends JSON string of lowercase hex digits to dst without reflection
+func (n BytesHex) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, BytesAsHex)
+}
+
+// UnmarshalJSON writes JSON to this type, lowercase hex digits is tried first, failures are *DecodeError
+func (n *BytesHex) UnmarshalJSON(data []byte) error {
+	return newDecodeError(n, data, n.unmarshalJSON(data, BytesAsHex))
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n BytesHex) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *BytesHex) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, BytesAsHex)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (BytesHex) JSONSchema() map[string]interface{} {
+	return bytesSchema(BytesAsHex)
+}
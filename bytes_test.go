@@ -1,6 +1,10 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -67,18 +71,56 @@ func TestMutateBytes(t *testing.T) {
 	nullableBytes := nullable.NewBytes(&basicBytes)
 	tests.AssertEqual(t, nullableBytes.Get(), basicBytes)
 
-	// Element modification must affect nullable bytes
+	// Element modification of the caller's array must not affect nullable bytes
 	basicBytes[0] = 0x21
-	basicBytes[1] = 0x77
+	tests.AssertEqual(t, (*nullableBytes.Get())[0], 0x33)
+
+	// Neither Get nor MustGet shares the internal array
+	(*nullableBytes.Get())[1] = 0x77
+	nullableBytes.MustGet()[1] = 0x77
+	tests.AssertEqual(t, nullableBytes.MustGet()[1], 0xcc)
+
+	nullableBytes.Set(&basicBytes)
 	basicBytes[2] = 0x8f
+	tests.AssertEqual(t, nullableBytes.MustGet(), []byte{0x21, 0xcc, 0xfd})
+
+	valueBytes := []byte{0x01}
+	fromValue := nullable.NewBytesValue(valueBytes)
+	valueBytes[0] = 0x02
+	tests.AssertEqual(t, fromValue.MustGet(), []byte{0x01})
+
+	// Neither does the driver value
+	driverValue, _ := fromValue.Value()
+	driverValue.([]byte)[0] = 0x03
+	tests.AssertEqual(t, fromValue.MustGet(), []byte{0x01})
+}
+
+func TestCloneBytes(t *testing.T) {
+	original := nullable.NewBytesValue([]byte("abc"))
+	clone := original.Clone()
+	tests.AssertEqual(t, clone, original)
+	if &clone.View()[0] == &original.View()[0] {
+		t.Error("Clone must not share the array")
+	}
 
-	tests.AssertEqual(t, (*nullableBytes.Get())[0], 0x21)
-	tests.AssertEqual(t, (*nullableBytes.Get())[1], 0x77)
-	tests.AssertEqual(t, (*nullableBytes.Get())[2], 0x8f)
+	// View shares the array with every copy
+	copied := original
+	tests.AssertEqual(t, &copied.View()[0] == &original.View()[0], true)
+	tests.AssertEqual(t, nullable.NewBytes(nil).View() == nil, true)
 
-	// Append must not affect nullable bytes
-	basicBytes = append(basicBytes, 0x31)
-	tests.AssertEqual(t, len(*nullableBytes.Get()), 3)
+	hex := nullable.NewBytesHex(&[]byte{0xff})
+	tests.AssertEqual(t, hex.Clone(), hex)
+}
+
+func TestScanRawBytes(t *testing.T) {
+	// database/sql reuses RawBytes for the next row
+	buffer := sql.RawBytes("abc")
+	var nullableBytes nullable.Bytes
+	if err := nullableBytes.Scan(buffer); err != nil {
+		t.Fatalf("Failed to scan sql.RawBytes because: %s", err)
+	}
+	buffer[0] = 'x'
+	tests.AssertEqual(t, nullableBytes.MustGet(), []byte("abc"))
 }
 
 func TestJSONBytes(t *testing.T) {
@@ -91,6 +133,153 @@ func TestJSONBytes(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewBytes(nil))
 }
 
+func TestEncodingBytes(t *testing.T) {
+	basicBytes := []byte{0xfb, 0xff}
+
+	serialized, _ := json.Marshal(nullable.NewBytes(&basicBytes))
+	tests.AssertEqual(t, string(serialized), `"+/8="`)
+
+	serialized, _ = json.Marshal(nullable.NewBytesBase64URL(&basicBytes))
+	tests.AssertEqual(t, string(serialized), `"-_8"`)
+
+	serialized, _ = json.Marshal(nullable.NewBytesHex(&basicBytes))
+	tests.AssertEqual(t, string(serialized), `"fbff"`)
+
+	serialized, _ = json.Marshal(nullable.NewBytesHex(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	inputs := []string{`"+/8="`, `"+/8"`, `"-_8="`, `"-_8"`}
+	for _, input := range inputs {
+		var decoded nullable.Bytes
+		if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, decoded.Get(), basicBytes)
+	}
+
+	// Hex is only read by hex encoding, because "deadbeef" is valid base64 too
+	var fromBase64 nullable.Bytes
+	json.Unmarshal([]byte(`"deadbeef"`), &fromBase64)
+	tests.AssertEqual(t, fromBase64.Get(), []byte{0x75, 0xe6, 0x9d, 0x6d, 0xe7, 0x9f})
+
+	var fromHex nullable.BytesHex
+	json.Unmarshal([]byte(`"deadbeef"`), &fromHex)
+	tests.AssertEqual(t, fromHex.Get(), []byte{0xde, 0xad, 0xbe, 0xef})
+
+	var fromGQL nullable.BytesHex
+	fromGQL.UnmarshalGQL("fbff")
+	tests.AssertEqual(t, fromGQL.Get(), basicBytes)
+
+	var invalid nullable.Bytes
+	if err := json.Unmarshal([]byte(`"not base64!"`), &invalid); err == nil {
+		t.Error("Expected error while unmarshalling unknown encoding")
+	}
+
+	nullable.SetBytesJSON(nullable.BytesAsHex)
+	defer nullable.SetBytesJSON(nullable.BytesAsBase64)
+
+	serialized, _ = json.Marshal(nullable.NewBytes(&basicBytes))
+	tests.AssertEqual(t, string(serialized), `"fbff"`)
+	marshalUnmarshalJSON(t, nullable.NewBytes(&basicBytes))
+}
+
+func TestGQLBytes(t *testing.T) {
+	basicBytes1 := []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalGQL(t, nullable.NewBytes(&basicBytes1))
+
+	basicBytes2 := []byte{}
+	marshalUnmarshalGQL(t, nullable.NewBytes(&basicBytes2))
+
+	marshalUnmarshalGQL(t, nullable.NewBytes(nil))
+}
+
+func TestTextBytes(t *testing.T) {
+	basicBytes1 := []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalText(t, nullable.NewBytes(&basicBytes1))
+
+	basicBytes2 := []byte{}
+	marshalUnmarshalText(t, nullable.NewBytes(&basicBytes2))
+
+	// Empty text is a valid empty []byte, so NULL becomes valid after the trip
+	nullText, _ := nullable.NewBytes(nil).MarshalText()
+	tests.AssertEqual(t, nullText, []byte{})
+
+	var emptyNullable nullable.Bytes
+	emptyNullable.UnmarshalText([]byte{})
+	tests.AssertEqual(t, emptyNullable.Get(), []byte{})
+}
+
+func TestBinaryBytes(t *testing.T) {
+	basicBytes1 := []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalBinary(t, nullable.NewBytes(&basicBytes1))
+
+	basicBytes2 := []byte{}
+	marshalUnmarshalBinary(t, nullable.NewBytes(&basicBytes2))
+
+	marshalUnmarshalBinary(t, nullable.NewBytes(nil))
+}
+
+func TestAccessorBytes(t *testing.T) {
+	nullableValue := nullable.NewBytesValue([]byte("abc"))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr([]byte("x")), []byte("abc"))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), []byte("abc"))
+	tests.AssertEqual(t, nullableValue.MustGet(), []byte("abc"))
+	tests.AssertEqual(t, nullableValue.Map(func(v []byte) []byte { return v[:1] }).MustGet(), []byte("a"))
+	tests.AssertEqual(t, nullableValue.FlatMap(func([]byte) nullable.Bytes { return nullable.NewBytes(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func([]byte) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func([]byte) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Bytes]([]byte("abc")), nullableValue)
+
+	nullValue := nullable.NewBytes(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr([]byte("x")), []byte("x"))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), []byte(nil))
+	tests.AssertEqual(t, nullValue.Map(func(v []byte) []byte { return v[:1] }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func([]byte) bool { return true }).IsNull(), true)
+}
+
+func TestCompareBytes(t *testing.T) {
+	low, high := nullable.NewBytesValue([]byte("abc")), nullable.NewBytesValue([]byte("abd"))
+	null := nullable.NewBytes(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewBytesValue([]byte("abc")), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewBytes(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewBytesValue([]byte("abc"))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewBytes(nil)), true)
+}
+
+func TestFormatBytes(t *testing.T) {
+	value := nullable.NewBytesValue([]byte("ab"))
+	null := nullable.NewBytes(nil)
+	tests.AssertEqual(t, value.String(), "[97 98]")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `[97 98]|"[97 98]"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "[97 98]")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewBytesValue([]byte{0x61, 0x62})")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewBytes(nil)")
+}
+
+func TestLogValueBytes(t *testing.T) {
+	value := nullable.NewBytesValue([]byte("ab")).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindAny)
+	tests.AssertEqual(t, value.Any(), []byte("ab"))
+
+	null := nullable.NewBytes(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestBytes(t *testing.T) {
 	type TestNullableByteArray struct {
 		ID       uint
@@ -0,0 +1,60 @@
+package nullable
+
+// NullOrder decides where NULL goes when comparing, like SQL "ORDER BY ... NULLS FIRST"
+type NullOrder int
+
+const (
+	// NullsFirst puts NULL before every valid value
+	NullsFirst NullOrder = iota
+	// NullsLast puts NULL after every valid value
+	NullsLast
+)
+
+// Ordered is implemented by every nullable type which has Compare
+type Ordered[N any] interface {
+	Compare(other N, order NullOrder) int
+}
+
+// compareValidity orders NULL against valid value, isDecided is false when both are valid
+func compareValidity(isValid, isOtherValid bool, order NullOrder) (result int, isDecided bool) {
+	switch {
+	case isValid && isOtherValid:
+		return 0, false
+	case isValid == isOtherValid:
+		return 0, true
+	case isValid == (order == NullsFirst):
+		return 1, true
+	}
+	return -1, true
+}
+
+// SortFunc creates comparison for slices.SortFunc, which orders items by a nullable field
+//
+//	slices.SortFunc(users, nullable.SortFunc(func(u User) nullable.Int64 { return u.Age }, nullable.NullsLast))
+func SortFunc[S any, N Ordered[N]](field func(item S) N, order NullOrder) func(a, b S) int {
+	return func(a, b S) int {
+		return field(a).Compare(field(b), order)
+	}
+}
+
+// Sorter implements sort.Interface, which orders items by a nullable field
+type Sorter[S any, N Ordered[N]] struct {
+	Items []S
+	Field func(item S) N
+	Order NullOrder
+}
+
+// Len implements sort.Interface
+func (s Sorter[S, N]) Len() int {
+	return len(s.Items)
+}
+
+// Less implements sort.Interface
+func (s Sorter[S, N]) Less(i, j int) bool {
+	return s.Field(s.Items[i]).Compare(s.Field(s.Items[j]), s.Order) < 0
+}
+
+// Swap implements sort.Interface
+func (s Sorter[S, N]) Swap(i, j int) {
+	s.Items[i], s.Items[j] = s.Items[j], s.Items[i]
+}
@@ -0,0 +1,76 @@
+package nullable_test
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type sortedUser struct {
+	Name string
+	Age  nullable.Int64
+}
+
+func sortedNames(users []sortedUser) []string {
+	names := make([]string, len(users))
+	for i, user := range users {
+		names[i] = user.Name
+	}
+	return names
+}
+
+func testUsers() []sortedUser {
+	return []sortedUser{
+		{"thor", nullable.NewInt64Value(30)},
+		{"loki", nullable.NewInt64(nil)},
+		{"odin", nullable.NewInt64Value(90)},
+		{"frigg", nullable.NewInt64Value(20)},
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	age := func(user sortedUser) nullable.Int64 { return user.Age }
+
+	users := testUsers()
+	slices.SortFunc(users, nullable.SortFunc(age, nullable.NullsLast))
+	tests.AssertEqual(t, sortedNames(users), []string{"frigg", "thor", "odin", "loki"})
+
+	slices.SortFunc(users, nullable.SortFunc(age, nullable.NullsFirst))
+	tests.AssertEqual(t, sortedNames(users), []string{"loki", "frigg", "thor", "odin"})
+}
+
+func TestSorter(t *testing.T) {
+	users := testUsers()
+	sort.Sort(nullable.Sorter[sortedUser, nullable.Int64]{
+		Items: users,
+		Field: func(user sortedUser) nullable.Int64 { return user.Age },
+		Order: nullable.NullsLast,
+	})
+	tests.AssertEqual(t, sortedNames(users), []string{"frigg", "thor", "odin", "loki"})
+}
+
+// equaler is satisfied by every nullable type, so generic code can compare any of them
+type equaler[T any] interface {
+	Equal(other T) bool
+}
+
+func countEqual[T equaler[T]](items []T, target T) int {
+	count := 0
+	for _, item := range items {
+		if item.Equal(target) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestEqualGeneric(t *testing.T) {
+	flags := []nullable.Bool{nullable.NewBoolValue(true), nullable.NewBool(nil), nullable.NewBool(nil)}
+	tests.AssertEqual(t, countEqual(flags, nullable.NewBool(nil)), 2)
+
+	ages := []nullable.Int64{nullable.NewInt64Value(30), nullable.NewInt64(nil)}
+	tests.AssertEqual(t, countEqual(ages, nullable.NewInt64Value(30)), 1)
+}
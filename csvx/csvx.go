@@ -0,0 +1,295 @@
+// Package csvx writes slices of structs to encoding/csv and reads them back,
+// with NULL-aware nullable columns.
+//
+// Columns are named by the csv tag, or the Go field name if untagged.
+// Values are formatted through the canonical text form of each type,
+// while NULL is written as a configurable sentinel:
+//
+//	type Payment struct {
+//		ID     uint64          `csv:"id"`
+//		Amount nullable.Int64  `csv:"amount"`
+//		PaidAt nullable.Time   `csv:"paid_at"`
+//	}
+//
+//	writer := csvx.NewWriter(csv.NewWriter(file))
+//	writer.Null = csvx.NullMySQL
+//	err := writer.Write(payments)
+package csvx
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/Thor-x86/nullable/internal/fields"
+)
+
+// Common NULL sentinels
+const (
+	// NullEmpty writes NULL as empty field, which can't be told apart from an empty string
+	NullEmpty = ""
+	// NullMySQL is understood by MySQL's LOAD DATA and SELECT ... INTO OUTFILE
+	NullMySQL = `\N`
+	// NullKeyword writes NULL literally
+	NullKeyword = "NULL"
+)
+
+// ErrNullCollision means a value is written as the same text as the NULL sentinel,
+// so reading it back would turn it into NULL
+var ErrNullCollision = errors.New("value equals the NULL sentinel")
+
+// errNilElement means a pointer in the written slice is nil
+var errNilElement = errors.New("nil element")
+
+// FieldError describes a field which can't be written or read
+type FieldError struct {
+	// Line is the line number where the record starts while writing, or where
+	// the field starts while reading, so quoted multi-line fields are counted
+	Line int
+	// Column is the column name from the header, empty when the whole record failed
+	Column string
+	// Field is the Go path of the field, like "Customer.Name"
+	Field string
+	// Value is the rejected text, empty when the field can't be formatted
+	Value string
+	// Err is the reason
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("csvx: line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("csvx: line %d, column %q (%s): %v", e.Line, e.Column, e.Field, e.Err)
+}
+
+// Unwrap returns the reason
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// column is a CSV column bound to a struct field
+type column struct {
+	name  string
+	path  string
+	index []int
+}
+
+// columnsOf lists the columns of given struct type in declaration order
+func columnsOf(structType reflect.Type) ([]column, error) {
+	var columns []column
+	err := fields.Walk(reflect.New(structType).Elem(), "csv", func(field fields.Field) error {
+		if !fields.IsText(field.Value.Type()) {
+			return fmt.Errorf("unsupported type %s of field %s", field.Value.Type(), field.Path)
+		}
+
+		name := field.Name
+		if name == "" {
+			name = field.Path
+		}
+		columns = append(columns, column{name: name, path: field.Path, index: field.Index})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("csvx: %v", err)
+	}
+	return columns, nil
+}
+
+// structElem returns the struct type of slice element, which may be a pointer to struct
+func structElem(sliceType reflect.Type) (reflect.Type, bool, error) {
+	if sliceType == nil || sliceType.Kind() != reflect.Slice {
+		return nil, false, fmt.Errorf("csvx: expected slice of struct, got %s", sliceType)
+	}
+	elemType := sliceType.Elem()
+	isPointer := elemType.Kind() == reflect.Ptr
+	if isPointer {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, false, fmt.Errorf("csvx: expected slice of struct, got %s", sliceType)
+	}
+	return elemType, isPointer, nil
+}
+
+// Writer writes slices of structs as CSV records
+type Writer struct {
+	// Null is written for NULL fields, default is NullEmpty.
+	// Write fails with ErrNullCollision when a value has the same text, like
+	// String "NULL" with NullKeyword, except for NullEmpty which can't tell
+	// an empty string from NULL.
+	Null string
+	// NoHeader skips the header record
+	NoHeader bool
+
+	csv *csv.Writer
+}
+
+// NewWriter creates a new Writer on top of w
+func NewWriter(w *csv.Writer) *Writer {
+	return &Writer{csv: w}
+}
+
+// Write writes the header and every element of slice, then flushes.
+// The slice may contain structs or non-nil pointers to structs.
+func (w *Writer) Write(slice interface{}) error {
+	structType, isPointer, err := structElem(reflect.TypeOf(slice))
+	if err != nil {
+		return err
+	}
+
+	columns, err := columnsOf(structType)
+	if err != nil {
+		return err
+	}
+
+	sliceValue := reflect.ValueOf(slice)
+	line := 1
+	record := make([]string, len(columns))
+	if !w.NoHeader {
+		for i, current := range columns {
+			record[i] = current.name
+		}
+		if err := w.csv.Write(record); err != nil {
+			return err
+		}
+		line++
+	}
+
+	for row := 0; row < sliceValue.Len(); row++ {
+		elem := sliceValue.Index(row)
+		if isPointer {
+			if elem.IsNil() {
+				return &FieldError{Line: line, Err: errNilElement}
+			}
+			elem = elem.Elem()
+		}
+
+		for i, current := range columns {
+			field := elem.FieldByIndex(current.index)
+			if fields.IsNull(field) {
+				record[i] = w.Null
+				continue
+			}
+
+			text, err := fields.Text(field)
+			if err != nil {
+				return &FieldError{Line: line, Column: current.name, Field: current.path, Err: err}
+			}
+			if w.Null != NullEmpty && text == w.Null {
+				return &FieldError{Line: line, Column: current.name, Field: current.path, Value: text, Err: ErrNullCollision}
+			}
+			record[i] = text
+		}
+		if err := w.csv.Write(record); err != nil {
+			return err
+		}
+		line += 1 + countNewlines(record)
+	}
+
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// countNewlines counts line breaks inside quoted fields of record
+func countNewlines(record []string) int {
+	count := 0
+	for _, field := range record {
+		count += strings.Count(field, "\n")
+	}
+	return count
+}
+
+// Reader reads CSV records into slices of structs
+type Reader struct {
+	// Null is read as NULL, default is NullEmpty
+	Null string
+	// NoHeader reads the first record as data, columns are bound in
+	// declaration order like Writer writes them
+	NoHeader bool
+
+	csv *csv.Reader
+}
+
+// NewReader creates a new Reader on top of r
+func NewReader(r *csv.Reader) *Reader {
+	return &Reader{csv: r}
+}
+
+// Read reads the header and every following record, then appends them to
+// the slice which dst points to. Columns are matched by header name,
+// unknown columns are ignored and missing columns stay NULL. Without
+// header, see NoHeader.
+func (r *Reader) Read(dst interface{}) error {
+	slicePointer := reflect.ValueOf(dst)
+	if slicePointer.Kind() != reflect.Ptr || slicePointer.IsNil() {
+		return fmt.Errorf("csvx: expected pointer to slice, got %T", dst)
+	}
+	sliceValue := slicePointer.Elem()
+	structType, isPointer, err := structElem(sliceValue.Type())
+	if err != nil {
+		return err
+	}
+
+	columns, err := columnsOf(structType)
+	if err != nil {
+		return err
+	}
+
+	bound := make([]*column, len(columns))
+	if r.NoHeader {
+		for i := range columns {
+			bound[i] = &columns[i]
+		}
+	} else {
+		header, err := r.csv.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		byName := make(map[string]column, len(columns))
+		for _, current := range columns {
+			byName[current.name] = current
+		}
+		bound = make([]*column, len(header))
+		for i, name := range header {
+			if current, ok := byName[name]; ok {
+				bound[i] = &current
+			}
+		}
+	}
+
+	for {
+		record, err := r.csv.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		elem := reflect.New(structType).Elem()
+		for i, text := range record {
+			if i >= len(bound) || bound[i] == nil || text == r.Null {
+				continue
+			}
+
+			field := elem.FieldByIndex(bound[i].index)
+			if err := fields.SetText(field, text); err != nil {
+				line, _ := r.csv.FieldPos(i)
+				return &FieldError{Line: line, Column: bound[i].name, Field: bound[i].path, Value: text, Err: err}
+			}
+		}
+
+		if isPointer {
+			elem = elem.Addr()
+		}
+		sliceValue.Set(reflect.Append(sliceValue, elem))
+	}
+}
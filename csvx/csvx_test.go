@@ -0,0 +1,222 @@
+package csvx_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+	"github.com/Thor-x86/nullable/csvx"
+	"gorm.io/gorm/utils/tests"
+)
+
+type testCustomer struct {
+	Name nullable.String `csv:"customer_name"`
+}
+
+type testPayment struct {
+	ID       uint64 `csv:"id"`
+	Customer testCustomer
+	Amount   nullable.Int64 `csv:"amount"`
+	Rate     nullable.Float64
+	PaidAt   nullable.Time `csv:"paid_at"`
+	Note     string        `csv:"-"`
+}
+
+func testPayments() []testPayment {
+	name := "Thor"
+	var amount int64 = -1250
+	rate := 0.5
+	paidAt := time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+
+	return []testPayment{
+		{
+			ID:       1,
+			Customer: testCustomer{Name: nullable.NewString(&name)},
+			Amount:   nullable.NewInt64(&amount),
+			Rate:     nullable.NewFloat64(&rate),
+			PaidAt:   nullable.NewTime(&paidAt),
+		},
+		{
+			ID: 2,
+		},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	for _, null := range []string{csvx.NullEmpty, csvx.NullMySQL, csvx.NullKeyword} {
+		var output bytes.Buffer
+		writer := csvx.NewWriter(csv.NewWriter(&output))
+		writer.Null = null
+		if err := writer.Write(testPayments()); err != nil {
+			t.Fatalf("Failed to write CSV because: %s", err)
+		}
+
+		expected := "id,customer_name,amount,Rate,paid_at\n" +
+			"1,Thor,-1250,0.5,2021-09-06T10:00:00Z\n" +
+			"2," + strings.Repeat(null+",", 3) + null + "\n"
+		tests.AssertEqual(t, output.String(), expected)
+	}
+}
+
+func TestWritePointers(t *testing.T) {
+	payments := testPayments()
+
+	var output bytes.Buffer
+	writer := csvx.NewWriter(csv.NewWriter(&output))
+	writer.NoHeader = true
+	if err := writer.Write([]*testPayment{&payments[1]}); err != nil {
+		t.Fatalf("Failed to write CSV because: %s", err)
+	}
+	tests.AssertEqual(t, output.String(), "2,,,,\n")
+}
+
+func TestWriteNilPointer(t *testing.T) {
+	writer := csvx.NewWriter(csv.NewWriter(&bytes.Buffer{}))
+	err := writer.Write([]*testPayment{nil})
+
+	var fieldErr *csvx.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected csvx.FieldError, got %v", err)
+	}
+	tests.AssertEqual(t, fieldErr.Line, 2)
+}
+
+func TestWriteNullCollision(t *testing.T) {
+	for _, null := range []string{csvx.NullMySQL, csvx.NullKeyword} {
+		payments := testPayments()
+		payments[0].Customer.Name = nullable.NewStringValue(null)
+
+		writer := csvx.NewWriter(csv.NewWriter(&bytes.Buffer{}))
+		writer.Null = null
+		err := writer.Write(payments)
+
+		var fieldErr *csvx.FieldError
+		if !errors.As(err, &fieldErr) {
+			t.Fatalf("Expected csvx.FieldError, got %v", err)
+		}
+		tests.AssertEqual(t, fieldErr.Column, "customer_name")
+		tests.AssertEqual(t, fieldErr.Value, null)
+		tests.AssertEqual(t, errors.Is(err, csvx.ErrNullCollision), true)
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	for _, null := range []string{csvx.NullEmpty, csvx.NullMySQL, csvx.NullKeyword} {
+		var output bytes.Buffer
+		writer := csvx.NewWriter(csv.NewWriter(&output))
+		writer.Null = null
+		if err := writer.Write(testPayments()); err != nil {
+			t.Fatalf("Failed to write CSV because: %s", err)
+		}
+
+		var payments []testPayment
+		reader := csvx.NewReader(csv.NewReader(&output))
+		reader.Null = null
+		if err := reader.Read(&payments); err != nil {
+			t.Fatalf("Failed to read CSV because: %s", err)
+		}
+		tests.AssertEqual(t, payments, testPayments())
+	}
+}
+
+func TestReadColumns(t *testing.T) {
+	input := "paid_at,unknown,id,amount\n" +
+		"2021-09-06,x,7,NULL\n"
+
+	var payments []*testPayment
+	reader := csvx.NewReader(csv.NewReader(strings.NewReader(input)))
+	reader.Null = csvx.NullKeyword
+	if err := reader.Read(&payments); err != nil {
+		t.Fatalf("Failed to read CSV because: %s", err)
+	}
+
+	tests.AssertEqual(t, len(payments), 1)
+	tests.AssertEqual(t, payments[0].ID, 7)
+	tests.AssertEqual(t, payments[0].Amount.Get(), nil)
+	tests.AssertEqual(t, payments[0].Customer.Name.Get(), nil)
+	tests.AssertEqual(t, payments[0].PaidAt.Get().Equal(time.Date(2021, 9, 6, 0, 0, 0, 0, time.UTC)), true)
+}
+
+func TestReadInvalid(t *testing.T) {
+	input := "id,amount\n" +
+		"1,10\n" +
+		"2,ten\n"
+
+	var payments []testPayment
+	err := csvx.NewReader(csv.NewReader(strings.NewReader(input))).Read(&payments)
+
+	var fieldErr *csvx.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected csvx.FieldError, got %v", err)
+	}
+	tests.AssertEqual(t, fieldErr.Line, 3)
+	tests.AssertEqual(t, fieldErr.Column, "amount")
+	tests.AssertEqual(t, fieldErr.Field, "Amount")
+	tests.AssertEqual(t, fieldErr.Value, "ten")
+	tests.AssertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+}
+
+func TestReadNoHeader(t *testing.T) {
+	var output bytes.Buffer
+	writer := csvx.NewWriter(csv.NewWriter(&output))
+	writer.NoHeader = true
+	writer.Null = csvx.NullMySQL
+	if err := writer.Write(testPayments()); err != nil {
+		t.Fatalf("Failed to write CSV because: %s", err)
+	}
+
+	var payments []testPayment
+	reader := csvx.NewReader(csv.NewReader(&output))
+	reader.NoHeader = true
+	reader.Null = csvx.NullMySQL
+	if err := reader.Read(&payments); err != nil {
+		t.Fatalf("Failed to read CSV because: %s", err)
+	}
+	tests.AssertEqual(t, payments, testPayments())
+}
+
+func TestMultilineLine(t *testing.T) {
+	input := "customer_name,amount\n" +
+		"\"Thor\nOdinson\",10\n" +
+		"Loki,ten\n"
+
+	var payments []testPayment
+	err := csvx.NewReader(csv.NewReader(strings.NewReader(input))).Read(&payments)
+
+	var fieldErr *csvx.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected csvx.FieldError, got %v", err)
+	}
+	tests.AssertEqual(t, fieldErr.Line, 4)
+
+	payments = testPayments()
+	payments[0].Customer.Name = nullable.NewStringValue("Thor\nOdinson")
+	payments[1].Customer.Name = nullable.NewStringValue(csvx.NullKeyword)
+	writer := csvx.NewWriter(csv.NewWriter(&bytes.Buffer{}))
+	writer.Null = csvx.NullKeyword
+	err = writer.Write(payments)
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected csvx.FieldError, got %v", err)
+	}
+	tests.AssertEqual(t, fieldErr.Line, 4)
+}
+
+func TestInvalidTarget(t *testing.T) {
+	writer := csvx.NewWriter(csv.NewWriter(&bytes.Buffer{}))
+	if err := writer.Write(testPayment{}); err == nil {
+		t.Error("Expected error while writing non-slice")
+	}
+	if err := writer.Write(nil); err == nil {
+		t.Error("Expected error while writing nil")
+	}
+
+	reader := csvx.NewReader(csv.NewReader(strings.NewReader("id\n1\n")))
+	if err := reader.Read([]testPayment{}); err == nil {
+		t.Error("Expected error while reading into non-pointer")
+	}
+}
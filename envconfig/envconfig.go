@@ -0,0 +1,101 @@
+// Package envconfig populates struct fields from environment variables.
+//
+// Fields are bound with the env tag, and parsed through their text
+// unmarshalling, so every nullable type stays NULL when its variable is unset:
+//
+//	type Config struct {
+//		DatabaseURL nullable.String `env:"DATABASE_URL,required"`
+//		Limit       nullable.Int64  `env:"LIMIT"`
+//	}
+//
+//	var cfg Config
+//	err := envconfig.Load(&cfg)
+package envconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Thor-x86/nullable/internal/fields"
+)
+
+// ErrRequired is wrapped by FieldError when a required variable is unset
+var ErrRequired = errors.New("required variable is not set")
+
+// FieldError describes a field which can't be loaded
+type FieldError struct {
+	// Field is the Go path of the field, like "Database.Port"
+	Field string
+	// Variable is the name of the environment variable
+	Variable string
+	// Err is the reason, either ErrRequired or the parsing error
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("envconfig: %s (%s): %v", e.Variable, e.Field, e.Err)
+}
+
+// Unwrap returns the reason, so errors.Is(err, ErrRequired) works
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors aggregates every field which can't be loaded, so all problems are reported at once
+type Errors []error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Load fills fields tagged with `env:"NAME"` from environment variables.
+// Unset variables leave their fields untouched, unless tagged with
+// `env:"NAME,required"`. Every failed field is reported in Errors.
+func Load(target interface{}) error {
+	return LoadFunc(target, os.LookupEnv)
+}
+
+// LoadFunc is the same as Load, but reads variables through lookup
+func LoadFunc(target interface{}, lookup func(name string) (string, bool)) error {
+	value, err := fields.Struct(target)
+	if err != nil {
+		return fmt.Errorf("envconfig: %v", err)
+	}
+
+	var failures Errors
+	err = fields.Walk(value, "env", func(field fields.Field) error {
+		if field.Name == "" {
+			return nil
+		}
+		if !fields.IsText(field.Value.Type()) {
+			return fmt.Errorf("unsupported type %s of field %s", field.Value.Type(), field.Path)
+		}
+
+		text, isSet := lookup(field.Name)
+		if !isSet {
+			if field.HasOption("required") {
+				failures = append(failures, &FieldError{Field: field.Path, Variable: field.Name, Err: ErrRequired})
+			}
+			return nil
+		}
+
+		if err := fields.SetText(field.Value, text); err != nil {
+			failures = append(failures, &FieldError{Field: field.Path, Variable: field.Name, Err: err})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("envconfig: %v", err)
+	}
+
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
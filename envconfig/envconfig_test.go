@@ -0,0 +1,119 @@
+package envconfig_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+	"github.com/Thor-x86/nullable/envconfig"
+	"gorm.io/gorm/utils/tests"
+)
+
+type testDatabase struct {
+	Host nullable.String `env:"DB_HOST,required"`
+	Port nullable.Uint16 `env:"DB_PORT"`
+}
+
+type testConfig struct {
+	Database testDatabase
+	Name     string         `env:"APP_NAME"`
+	Limit    nullable.Int64 `env:"LIMIT"`
+	Debug    nullable.Bool  `env:"DEBUG"`
+	Since    nullable.Time  `env:"SINCE"`
+	Ignored  nullable.Int64 `env:"-"`
+	Untagged nullable.Int64
+}
+
+func lookupMap(variables map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, isSet := variables[name]
+		return value, isSet
+	}
+}
+
+func TestLoadFunc(t *testing.T) {
+	var cfg testConfig
+	err := envconfig.LoadFunc(&cfg, lookupMap(map[string]string{
+		"DB_HOST":  "localhost",
+		"APP_NAME": "nullable",
+		"LIMIT":    "0",
+		"SINCE":    "2021-09-06T10:00:00Z",
+		"Untagged": "1",
+	}))
+	if err != nil {
+		t.Fatalf("Failed to load config because: %s", err)
+	}
+
+	tests.AssertEqual(t, cfg.Database.Host.Get(), "localhost")
+	tests.AssertEqual(t, cfg.Database.Port.Get(), nil)
+	tests.AssertEqual(t, cfg.Name, "nullable")
+	tests.AssertEqual(t, cfg.Limit.Get(), 0)
+	tests.AssertEqual(t, cfg.Debug.Get(), nil)
+	tests.AssertEqual(t, cfg.Since.Get().Equal(time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)), true)
+	tests.AssertEqual(t, cfg.Ignored.Get(), nil)
+	tests.AssertEqual(t, cfg.Untagged.Get(), nil)
+}
+
+func TestLoad(t *testing.T) {
+	os.Setenv("DB_HOST", "db.example.com")
+	os.Setenv("DB_PORT", "5432")
+	defer os.Unsetenv("DB_HOST")
+	defer os.Unsetenv("DB_PORT")
+
+	var cfg testConfig
+	if err := envconfig.Load(&cfg); err != nil {
+		t.Fatalf("Failed to load config because: %s", err)
+	}
+	tests.AssertEqual(t, cfg.Database.Host.Get(), "db.example.com")
+	tests.AssertEqual(t, cfg.Database.Port.Get(), 5432)
+}
+
+func TestLoadErrors(t *testing.T) {
+	var cfg testConfig
+	err := envconfig.LoadFunc(&cfg, lookupMap(map[string]string{
+		"DB_PORT": "65536",
+		"DEBUG":   "maybe",
+	}))
+
+	var failures envconfig.Errors
+	if !errors.As(err, &failures) {
+		t.Fatalf("Expected envconfig.Errors, got %v", err)
+	}
+	tests.AssertEqual(t, len(failures), 3)
+
+	var fieldErr *envconfig.FieldError
+	if !errors.As(failures[0], &fieldErr) {
+		t.Fatalf("Expected envconfig.FieldError, got %v", failures[0])
+	}
+	tests.AssertEqual(t, fieldErr.Field, "Database.Host")
+	tests.AssertEqual(t, fieldErr.Variable, "DB_HOST")
+	tests.AssertEqual(t, errors.Is(fieldErr, envconfig.ErrRequired), true)
+
+	tests.AssertEqual(t, failures[1].(*envconfig.FieldError).Variable, "DB_PORT")
+	tests.AssertEqual(t, failures[2].(*envconfig.FieldError).Variable, "DEBUG")
+}
+
+func TestLoadInvalidTarget(t *testing.T) {
+	var cfg testConfig
+	if err := envconfig.Load(cfg); err == nil {
+		t.Error("Expected error while loading into non-pointer")
+	}
+
+	var unsupported struct {
+		Timeout []string `env:"TIMEOUT"`
+	}
+	if err := envconfig.Load(&unsupported); err == nil {
+		t.Error("Expected error while loading into unsupported field")
+	}
+
+	var nested struct {
+		Database *struct {
+			Port uint16 `env:"PORT"`
+		}
+	}
+	if err := envconfig.Load(&nested); err == nil {
+		t.Error("Expected error while loading into pointer to struct")
+	}
+}
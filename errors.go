@@ -0,0 +1,194 @@
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Sentinels to check the kind of failure with errors.Is
+var (
+	// ErrScan matches every *ScanError
+	ErrScan = errors.New("nullable: scan failed")
+	// ErrOverflow matches every *OverflowError
+	ErrOverflow = errors.New("nullable: value out of range")
+	// ErrUnsupportedSource matches every *UnsupportedSourceError
+	ErrUnsupportedSource = errors.New("nullable: unsupported source type")
+	// ErrDecode matches every *DecodeError
+	ErrDecode = errors.New("nullable: malformed input")
+)
+
+// ScanError is returned by Scan when a database value can't be written to a nullable type,
+// which usually means the column doesn't match the Go type
+type ScanError struct {
+	// Target is the nullable type, like nullable.Uint8
+	Target reflect.Type
+	// Source is the Go type of database value, like []uint8
+	Source reflect.Type
+	// Value is the raw database value
+	Value interface{}
+	// Err is the reason, either *OverflowError, *UnsupportedSourceError, or conversion error
+	Err error
+}
+
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("nullable: can't scan %v into %v: %v", e.Source, e.Target, e.Err)
+}
+
+// Unwrap returns the reason
+func (e *ScanError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrScan) work
+func (e *ScanError) Is(target error) bool {
+	return target == ErrScan
+}
+
+// OverflowError means the value doesn't fit into the nullable type
+type OverflowError struct {
+	// Target is the nullable type, like nullable.Int8
+	Target reflect.Type
+	// Value is the raw value
+	Value interface{}
+}
+
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("value %s out of range of %v", formatRaw(e.Value), e.Target)
+}
+
+// Is makes errors.Is(err, ErrOverflow) work
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// UnsupportedSourceError means the Go type of the value can't be converted into the nullable type at all
+type UnsupportedSourceError struct {
+	// Target is the nullable type, like nullable.Time
+	Target reflect.Type
+	// Source is the Go type of the value, like bool
+	Source reflect.Type
+}
+
+func (e *UnsupportedSourceError) Error() string {
+	return fmt.Sprintf("unsupported source type %v for %v", e.Source, e.Target)
+}
+
+// Is makes errors.Is(err, ErrUnsupportedSource) work
+func (e *UnsupportedSourceError) Is(target error) bool {
+	return target == ErrUnsupportedSource
+}
+
+// DecodeError is returned by UnmarshalJSON when the input is malformed or has the wrong type,
+// which usually means a bad client payload
+type DecodeError struct {
+	// Target is the nullable type, like nullable.Int64
+	Target reflect.Type
+	// Data is the raw JSON input
+	Data string
+	// Err is the reason
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("nullable: can't decode JSON %s into %v: %v", e.Data, e.Target, e.Err)
+}
+
+// Unwrap returns the reason
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrDecode) work
+func (e *DecodeError) Is(target error) bool {
+	return target == ErrDecode
+}
+
+// formatRaw formats raw value for error message, bytes are shown as text
+func formatRaw(value interface{}) string {
+	if buffer, isBytes := value.([]byte); isBytes {
+		return strconv.Quote(string(buffer))
+	}
+	if text, isText := value.(string); isText {
+		return strconv.Quote(text)
+	}
+	return fmt.Sprint(value)
+}
+
+// targetType returns the nullable type behind pointer receiver
+func targetType(target interface{}) reflect.Type {
+	return reflect.TypeOf(target).Elem()
+}
+
+// newScanError wraps the reason of failed Scan
+func newScanError(value, target interface{}, err error) error {
+	return &ScanError{Target: targetType(target), Source: reflect.TypeOf(value), Value: value, Err: err}
+}
+
+// newOverflowError creates *ScanError caused by value which doesn't fit into target
+func newOverflowError(value, target interface{}) error {
+	return newScanError(value, target, overflowOf(value, target))
+}
+
+// overflowOf creates *OverflowError of value which doesn't fit into target
+func overflowOf(value, target interface{}) *OverflowError {
+	return &OverflowError{Target: targetType(target), Value: value}
+}
+
+// newUnsupportedError creates *ScanError caused by unsupported Go type of value
+func newUnsupportedError(value, target interface{}) error {
+	return newScanError(value, target, &UnsupportedSourceError{Target: targetType(target), Source: reflect.TypeOf(value)})
+}
+
+// newParseError classifies failed strconv parsing while scanning
+func newParseError(value, target interface{}, err error) error {
+	var numError *strconv.NumError
+	if errors.As(err, &numError) && numError.Err == strconv.ErrRange {
+		return newOverflowError(value, target)
+	}
+	return newScanError(value, target, err)
+}
+
+// newFallbackError classifies failed convertAssign while scanning
+func newFallbackError(value, target interface{}, err error) error {
+	if !isConvertibleSource(value) {
+		return newUnsupportedError(value, target)
+	}
+	return newScanError(value, target, err)
+}
+
+// isConvertibleSource reports whether convertAssign may convert the value into basic types
+func isConvertibleSource(value interface{}) bool {
+	if _, isTime := value.(time.Time); isTime {
+		return true
+	}
+	switch kind := reflect.TypeOf(value).Kind(); kind {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return reflect.TypeOf(value).Elem().Kind() == reflect.Uint8
+	}
+	return false
+}
+
+// newDecodeError wraps the reason of failed UnmarshalJSON, nil stays nil
+func newDecodeError(target interface{}, data []byte, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &DecodeError{Target: targetType(target), Data: string(data), Err: err}
+}
+
+// scanReason unwraps *ScanError, so lenient decoding reports the reason only
+func scanReason(err error) error {
+	var scanError *ScanError
+	if errors.As(err, &scanError) {
+		return scanError.Err
+	}
+	return err
+}
@@ -0,0 +1,108 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanErrorOverflow(t *testing.T) {
+	var nullableInt8 nullable.Int8
+	err := nullableInt8.Scan(int64(300))
+
+	var scanError *nullable.ScanError
+	if !errors.As(err, &scanError) {
+		t.Fatalf("Expected ScanError, got %v", err)
+	}
+	tests.AssertEqual(t, scanError.Target, reflect.TypeOf(nullable.Int8{}))
+	tests.AssertEqual(t, scanError.Source, reflect.TypeOf(int64(0)))
+	tests.AssertEqual(t, scanError.Value, int64(300))
+
+	var overflowError *nullable.OverflowError
+	if !errors.As(err, &overflowError) {
+		t.Fatalf("Expected OverflowError, got %v", err)
+	}
+	tests.AssertEqual(t, overflowError.Value, int64(300))
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrScan), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrOverflow), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrUnsupportedSource), false)
+	tests.AssertEqual(t, nullableInt8.Get(), nil)
+
+	var nullableUint16 nullable.Uint16
+	tests.AssertEqual(t, errors.Is(nullableUint16.Scan(int64(-1)), nullable.ErrOverflow), true)
+	tests.AssertEqual(t, errors.Is(nullableUint16.Scan([]byte("65536")), nullable.ErrOverflow), true)
+
+	var nullableFloat32 nullable.Float32
+	tests.AssertEqual(t, errors.Is(nullableFloat32.Scan(1e300), nullable.ErrOverflow), true)
+}
+
+func TestScanErrorUnsupported(t *testing.T) {
+	var nullableTime nullable.Time
+	err := nullableTime.Scan(true)
+
+	var unsupportedError *nullable.UnsupportedSourceError
+	if !errors.As(err, &unsupportedError) {
+		t.Fatalf("Expected UnsupportedSourceError, got %v", err)
+	}
+	tests.AssertEqual(t, unsupportedError.Source, reflect.TypeOf(true))
+	tests.AssertEqual(t, unsupportedError.Target, reflect.TypeOf(nullable.Time{}))
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrScan), true)
+
+	var nullableInt nullable.Int
+	tests.AssertEqual(t, errors.Is(nullableInt.Scan(struct{}{}), nullable.ErrUnsupportedSource), true)
+}
+
+func TestScanErrorConversion(t *testing.T) {
+	var nullableInt nullable.Int
+	err := nullableInt.Scan([]byte("abc"))
+
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrScan), true)
+	tests.AssertEqual(t, errors.Is(err, strconv.ErrSyntax), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrOverflow), false)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrUnsupportedSource), false)
+}
+
+func TestScanErrorGORM(t *testing.T) {
+	var row struct {
+		Value nullable.Int8
+	}
+	err := DB.Raw("SELECT 300 AS value").Scan(&row).Error
+	if !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while scanning 300 into Int8, got %v", err)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	var payload struct {
+		Age nullable.Uint8 `json:"age"`
+	}
+	err := json.Unmarshal([]byte(`{"age": "old"}`), &payload)
+
+	var decodeError *nullable.DecodeError
+	if !errors.As(err, &decodeError) {
+		t.Fatalf("Expected DecodeError, got %v", err)
+	}
+	tests.AssertEqual(t, decodeError.Target, reflect.TypeOf(nullable.Uint8{}))
+	tests.AssertEqual(t, decodeError.Data, `"old"`)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrDecode), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrScan), false)
+
+	var unixTime nullable.TimeUnix
+	tests.AssertEqual(t, errors.Is(unixTime.UnmarshalJSON([]byte("1.5")), nullable.ErrDecode), true)
+}
+
+func TestDecodeErrorLenient(t *testing.T) {
+	nullable.SetJSONDecoding(nullable.LenientJSON)
+	defer nullable.SetJSONDecoding(nullable.StrictJSON)
+
+	var nullableInt8 nullable.Int8
+	err := nullableInt8.UnmarshalJSON([]byte(`"300"`))
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrDecode), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrOverflow), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrScan), false)
+}
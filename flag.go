@@ -0,0 +1,283 @@
+package nullable
+
+import (
+	"encoding"
+	"flag"
+	"reflect"
+)
+
+// flagValue adapts nullable type into flag.Value. The nullable types can't
+// implement flag.Value by themselves because their Set takes a pointer.
+type flagValue struct {
+	target flagTarget
+}
+
+// boolFlagValue is flagValue which can be passed without value, like "-verbose"
+type boolFlagValue struct {
+	flagValue
+}
+
+type flagTarget interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+// Flag wraps pointer of any nullable type into flag.Getter, so it can be
+// registered with flag.Var. The target stays NULL if the flag is not passed.
+// String and Bytes have no text for NULL: "-name=" sets a valid empty value,
+// and the empty default shown by flag.PrintDefaults may mean either.
+func Flag(target flagTarget) flag.Getter {
+	if _, isBool := target.(*Bool); isBool {
+		return &boolFlagValue{flagValue{target}}
+	}
+	return &flagValue{target}
+}
+
+// String implements flag.Value interface
+func (f *flagValue) String() string {
+	if f == nil || f.target == nil {
+		return ""
+	}
+	text, err := f.target.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// Set implements flag.Value interface
+func (f *flagValue) Set(text string) error {
+	return f.target.UnmarshalText([]byte(text))
+}
+
+// Get implements flag.Getter interface, it returns the nullable value itself
+func (f *flagValue) Get() interface{} {
+	return reflect.ValueOf(f.target).Elem().Interface()
+}
+
+// IsBoolFlag tells flag package that "-name" is the same as "-name=true"
+func (f *boolFlagValue) IsBoolFlag() bool {
+	return true
+}
+
+// FlagSet is flag.FlagSet with additional nullable flag definitions.
+// Wrap flag.CommandLine to define nullable flags on the command line:
+//
+//	fs := nullable.FlagSet{FlagSet: flag.CommandLine}
+//	limit := fs.NullInt64("limit", "maximum number of rows")
+type FlagSet struct {
+	*flag.FlagSet
+}
+
+// NewFlagSet creates a new flag set, see flag.NewFlagSet
+func NewFlagSet(name string, errorHandling flag.ErrorHandling) *FlagSet {
+	return &FlagSet{flag.NewFlagSet(name, errorHandling)}
+}
+
+// NullBoolVar defines nullable boolean flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullBoolVar(p *Bool, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullBool defines nullable boolean flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullBool(name string, usage string) *Bool {
+	p := new(Bool)
+	f.NullBoolVar(p, name, usage)
+	return p
+}
+
+// NullByteVar defines nullable single byte flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullByteVar(p *Byte, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullByte defines nullable single byte flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullByte(name string, usage string) *Byte {
+	p := new(Byte)
+	f.NullByteVar(p, name, usage)
+	return p
+}
+
+// NullBytesVar defines nullable array of bytes flag stored at p, p stays NULL if the flag is not passed,
+// while "-name=" sets a valid empty array
+func (f *FlagSet) NullBytesVar(p *Bytes, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullBytes defines nullable array of bytes flag, the result stays NULL if the flag is not passed,
+// while "-name=" sets a valid empty array
+func (f *FlagSet) NullBytes(name string, usage string) *Bytes {
+	p := new(Bytes)
+	f.NullBytesVar(p, name, usage)
+	return p
+}
+
+// NullFloat32Var defines nullable float flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullFloat32Var(p *Float32, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullFloat32 defines nullable float flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullFloat32(name string, usage string) *Float32 {
+	p := new(Float32)
+	f.NullFloat32Var(p, name, usage)
+	return p
+}
+
+// NullFloat64Var defines nullable double precision float flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullFloat64Var(p *Float64, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullFloat64 defines nullable double precision float flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullFloat64(name string, usage string) *Float64 {
+	p := new(Float64)
+	f.NullFloat64Var(p, name, usage)
+	return p
+}
+
+// NullIntVar defines nullable integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullIntVar(p *Int, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullInt defines nullable integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullInt(name string, usage string) *Int {
+	p := new(Int)
+	f.NullIntVar(p, name, usage)
+	return p
+}
+
+// NullInt8Var defines nullable 8-bit integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullInt8Var(p *Int8, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullInt8 defines nullable 8-bit integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullInt8(name string, usage string) *Int8 {
+	p := new(Int8)
+	f.NullInt8Var(p, name, usage)
+	return p
+}
+
+// NullInt16Var defines nullable 16-bit integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullInt16Var(p *Int16, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullInt16 defines nullable 16-bit integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullInt16(name string, usage string) *Int16 {
+	p := new(Int16)
+	f.NullInt16Var(p, name, usage)
+	return p
+}
+
+// NullInt32Var defines nullable 32-bit integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullInt32Var(p *Int32, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullInt32 defines nullable 32-bit integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullInt32(name string, usage string) *Int32 {
+	p := new(Int32)
+	f.NullInt32Var(p, name, usage)
+	return p
+}
+
+// NullInt64Var defines nullable 64-bit integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullInt64Var(p *Int64, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullInt64 defines nullable 64-bit integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullInt64(name string, usage string) *Int64 {
+	p := new(Int64)
+	f.NullInt64Var(p, name, usage)
+	return p
+}
+
+// NullStringVar defines nullable string flag stored at p, p stays NULL if the flag is not passed,
+// while "-name=" sets a valid empty string
+func (f *FlagSet) NullStringVar(p *String, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullString defines nullable string flag, the result stays NULL if the flag is not passed,
+// while "-name=" sets a valid empty string
+func (f *FlagSet) NullString(name string, usage string) *String {
+	p := new(String)
+	f.NullStringVar(p, name, usage)
+	return p
+}
+
+// NullTimeVar defines nullable time flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullTimeVar(p *Time, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullTime defines nullable time flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullTime(name string, usage string) *Time {
+	p := new(Time)
+	f.NullTimeVar(p, name, usage)
+	return p
+}
+
+// NullUintVar defines nullable unsigned integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullUintVar(p *Uint, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullUint defines nullable unsigned integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullUint(name string, usage string) *Uint {
+	p := new(Uint)
+	f.NullUintVar(p, name, usage)
+	return p
+}
+
+// NullUint8Var defines nullable 8-bit unsigned integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullUint8Var(p *Uint8, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullUint8 defines nullable 8-bit unsigned integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullUint8(name string, usage string) *Uint8 {
+	p := new(Uint8)
+	f.NullUint8Var(p, name, usage)
+	return p
+}
+
+// NullUint16Var defines nullable 16-bit unsigned integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullUint16Var(p *Uint16, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullUint16 defines nullable 16-bit unsigned integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullUint16(name string, usage string) *Uint16 {
+	p := new(Uint16)
+	f.NullUint16Var(p, name, usage)
+	return p
+}
+
+// NullUint32Var defines nullable 32-bit unsigned integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullUint32Var(p *Uint32, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullUint32 defines nullable 32-bit unsigned integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullUint32(name string, usage string) *Uint32 {
+	p := new(Uint32)
+	f.NullUint32Var(p, name, usage)
+	return p
+}
+
+// NullUint64Var defines nullable 64-bit unsigned integer flag stored at p, p stays NULL if the flag is not passed
+func (f *FlagSet) NullUint64Var(p *Uint64, name string, usage string) {
+	f.Var(Flag(p), name, usage)
+}
+
+// NullUint64 defines nullable 64-bit unsigned integer flag, the result stays NULL if the flag is not passed
+func (f *FlagSet) NullUint64(name string, usage string) *Uint64 {
+	p := new(Uint64)
+	f.NullUint64Var(p, name, usage)
+	return p
+}
@@ -0,0 +1,121 @@
+package nullable_test
+
+import (
+	"bytes"
+	"encoding"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func marshalUnmarshalText(t *testing.T, target interface{}) {
+	serialized, err := target.(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		t.Fatalf("Failed to marshal %T to text because: %s", target, err)
+		return
+	}
+
+	unserialized := reflect.New(reflect.TypeOf(target))
+	if err := unserialized.Interface().(encoding.TextUnmarshaler).UnmarshalText(serialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T from text because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized.Elem().Interface(), target)
+}
+
+func TestFlagSet(t *testing.T) {
+	fs := nullable.NewFlagSet("test", flag.ContinueOnError)
+	limit := fs.NullInt64("limit", "maximum number of rows")
+	offset := fs.NullUint("offset", "rows to skip")
+	verbose := fs.NullBool("verbose", "print more")
+	name := fs.NullString("name", "filter by name")
+	since := fs.NullTime("since", "filter by creation time")
+
+	if err := fs.Parse([]string{"-limit=0", "-verbose", "-name=", "-since=2021-09-06T10:00:00Z"}); err != nil {
+		t.Fatalf("Failed to parse flags because: %s", err)
+	}
+
+	tests.AssertEqual(t, limit.Get(), 0)
+	tests.AssertEqual(t, offset.Get(), nil)
+	tests.AssertEqual(t, verbose.Get(), true)
+	tests.AssertEqual(t, name.Get(), "")
+	tests.AssertEqual(t, since.Get().Unix(), int64(1630922400))
+}
+
+func TestFlagSetInvalid(t *testing.T) {
+	fs := nullable.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&bytes.Buffer{})
+	fs.NullInt8("level", "nesting level")
+
+	if err := fs.Parse([]string{"-level=128"}); err == nil {
+		t.Error("Expected error while parsing out of range 8-bit integer")
+	}
+}
+
+func TestFlagVar(t *testing.T) {
+	var ratio nullable.Float64
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(nullable.Flag(&ratio), "ratio", "sampling ratio")
+
+	if err := fs.Parse([]string{"-ratio", "0.25"}); err != nil {
+		t.Fatalf("Failed to parse flags because: %s", err)
+	}
+	tests.AssertEqual(t, ratio.Get(), 0.25)
+
+	getter := fs.Lookup("ratio").Value.(flag.Getter)
+	tests.AssertEqual(t, getter.Get(), ratio)
+	tests.AssertEqual(t, getter.String(), "0.25")
+}
+
+func TestFlagDefaults(t *testing.T) {
+	var output bytes.Buffer
+	fs := nullable.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&output)
+	fs.NullInt64("limit", "maximum number of rows")
+
+	var page uint32 = 1
+	pageFlag := nullable.NewUint32(&page)
+	fs.NullUint32Var(&pageFlag, "page", "page number")
+
+	fs.PrintDefaults()
+	if strings.Contains(output.String(), "maximum number of rows (default") {
+		t.Errorf("NULL flag must not print default value, got %q", output.String())
+	}
+	if !strings.Contains(output.String(), "page number (default 1)") {
+		t.Errorf("Valid flag must print default value, got %q", output.String())
+	}
+}
+
+func TestFlagEmptyText(t *testing.T) {
+	fs := nullable.NewFlagSet("test", flag.ContinueOnError)
+	name := fs.NullString("name", "filter by name")
+	data := fs.NullBytes("data", "raw payload")
+	limit := fs.NullInt64("limit", "maximum number of rows")
+
+	if err := fs.Parse([]string{"-name=", "-data="}); err != nil {
+		t.Fatalf("Failed to parse flags because: %s", err)
+	}
+	tests.AssertEqual(t, name.Valid(), true)
+	tests.AssertEqual(t, name.Get(), "")
+	tests.AssertEqual(t, data.Valid(), true)
+	tests.AssertEqual(t, limit.Valid(), false)
+
+	// NULL has no text of its own, so it comes back as a valid empty value
+	var nullString nullable.String
+	text, _ := nullString.MarshalText()
+	if err := nullString.UnmarshalText(text); err != nil {
+		t.Fatalf("Failed to unmarshal empty text because: %s", err)
+	}
+	tests.AssertEqual(t, nullString.Valid(), true)
+
+	var nullBytes nullable.Bytes
+	text, _ = nullBytes.MarshalText()
+	if err := nullBytes.UnmarshalText(text); err != nil {
+		t.Fatalf("Failed to unmarshal empty text because: %s", err)
+	}
+	tests.AssertEqual(t, nullBytes.Valid(), true)
+}
@@ -1,10 +1,20 @@
 package nullable
 
 import (
+	"cmp"
+	"context"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
 )
 
@@ -28,6 +38,14 @@ func NewFloat32(value *float32) Float32 {
 	}
 }
 
+// NewFloat32Value creates a new valid nullable float from plain value
+func NewFloat32Value(value float32) Float32 {
+	return Float32{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or float
 func (n Float32) Get() *float32 {
 	if !n.isValid {
@@ -46,30 +64,307 @@ func (n *Float32) Set(value *float32) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Float32) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Float32) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Float32) ValueOr(fallback float32) float32 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Float32) ValueOrZero() float32 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Float32) MustGet() float32 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Float32")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Float32) Clone() Float32 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Float32) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatFloat(float64(n.realValue), 'g', -1, 32)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Float32) GoString() string {
+	if !n.isValid {
+		return "nullable.NewFloat32(nil)"
+	}
+	return fmt.Sprintf("nullable.NewFloat32Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Float32) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Float32) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Float64Value(float64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Float32) Map(fn func(float32) float32) Float32 {
+	if !n.isValid {
+		return n
+	}
+	return NewFloat32Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Float32) FlatMap(fn func(float32) Float32) Float32 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Float32) Filter(fn func(float32) bool) Float32 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewFloat32(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes, floats are compared with cmp.Compare, so NaN is the smallest
+func (n Float32) Compare(other Float32, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value, NaN equals NaN like Compare
+func (n Float32) Equal(other Float32) bool {
+	return n.isValid == other.isValid && (!n.isValid || cmp.Compare(n.realValue, other.realValue) == 0)
+}
+
+// Add returns n + other, NULL when either is NULL
+func (n Float32) Add(other Float32) Float32 {
+	if !n.isValid || !other.isValid {
+		return NewFloat32(nil)
+	}
+	return NewFloat32Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL
+func (n Float32) Sub(other Float32) Float32 {
+	if !n.isValid || !other.isValid {
+		return NewFloat32(nil)
+	}
+	return NewFloat32Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL
+func (n Float32) Mul(other Float32) Float32 {
+	if !n.isValid || !other.isValid {
+		return NewFloat32(nil)
+	}
+	return NewFloat32Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Float32) Div(other Float32) (Float32, error) {
+	if !n.isValid || !other.isValid {
+		return NewFloat32(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewFloat32(nil), divisionByZero()
+	}
+	return NewFloat32Value(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL
+func (n Float32) Neg() Float32 {
+	if !n.isValid {
+		return n
+	}
+	return NewFloat32Value(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL
+func (n Float32) Abs() Float32 {
+	if !n.isValid {
+		return n
+	}
+	return NewFloat32Value(float32(math.Abs(float64(n.realValue))))
+}
+
+// MarshalJSON converts current value to JSON, see SetNaNPolicy for NaN and Infinity
 func (n Float32) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if n.isValid && isNonFinite(float64(n.realValue)) {
+		return marshalNonFiniteJSON(float64(n.realValue))
+	}
+	return n.AppendJSON(nil), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// AppendJSON appends JSON form of current value to dst without reflection.
+// NaN and Infinity refused by SetNaNPolicy become null, MarshalJSON returns error instead.
+func (n Float32) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	if isNonFinite(float64(n.realValue)) {
+		return appendNonFiniteJSON(dst, float64(n.realValue))
+	}
+	return appendJSONFloat(dst, float64(n.realValue), 32)
+}
+
+// UnmarshalJSON writes JSON to this type, see SetNaNPolicy for NaN and Infinity, failures are *DecodeError
 func (n *Float32) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Float32) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
 	var parsed float32
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if isLenientJSON() {
+		var scanned Float32
+		if err := readLenientJSON(&scanned, data); err != nil {
+			return err
+		}
+		parsed = scanned.realValue
+	} else if data[0] == '"' {
+		nonFinite, err := parseNonFiniteJSON(data)
+		if err != nil {
+			return err
+		}
+		parsed = float32(nonFinite)
+	} else if fast, isFast := parseJSONFloat(data, 32); isFast {
+		parsed = float32(fast)
+	} else {
+		var slow float32
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	isValid, err := checkNonFinite(float64(parsed))
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		parsed = 0
+	}
+
+	n.isValid = isValid
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Float32) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendFloat(nil, float64(n.realValue), 'g', -1, 32), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Float32) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(string(text), 32)
+	if err != nil {
 		return err
 	}
 
+	n.isValid = true
+	n.realValue = float32(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Float32) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Float32) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Float32) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, 4)
+	if n.isValid {
+		var encoded [4]byte
+		binary.BigEndian.PutUint32(encoded[:], math.Float32bits(n.realValue))
+		buffer = append(buffer, encoded[:]...)
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Float32) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Float32")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	if err := readFixed(payload, 4, "Float32"); err != nil {
+		return err
+	}
+	parsed := math.Float32frombits(binary.BigEndian.Uint32(payload))
+
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Float32) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Float32) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Float32) Scan(value interface{}) error {
 	if value == nil {
@@ -77,24 +372,68 @@ func (n *Float32) Scan(value interface{}) error {
 		return nil
 	}
 
-	var f64 float64
-	if err := convertAssign(&f64, value); err != nil {
+	parsed, err := scanFloat(value, 32, n)
+	if err != nil {
 		return err
 	}
-	n.realValue = float32(f64)
+	n.realValue = float32(parsed)
 
 	n.isValid = true
 	return nil
 }
 
-// Value implements the driver Valuer interface.
+// Value implements the driver Valuer interface, see SetNaNPolicy for NaN and Infinity
 func (n Float32) Value() (driver.Value, error) {
 	if !n.isValid {
 		return nil, nil
 	}
+
+	isValid, err := checkNonFinite(float64(n.realValue))
+	if err != nil || !isValid {
+		return nil, err
+	}
 	return float64(n.realValue), nil
 }
 
+// FromSQLNullFloat32 converts sql.NullFloat64 to nullable float, fails with *OverflowError when out of range
+func FromSQLNullFloat32(value sql.NullFloat64) (Float32, error) {
+	if !value.Valid {
+		return NewFloat32(nil), nil
+	}
+	v := value.Float64
+	if !math.IsInf(v, 0) && math.IsInf(float64(float32(v)), 0) {
+		return NewFloat32(nil), overflowOf(v, new(Float32))
+	}
+	return NewFloat32Value(float32(v)), nil
+}
+
+// SQLNull converts to sql.NullFloat64
+func (n Float32) SQLNull() sql.NullFloat64 {
+	return sql.NullFloat64{Float64: float64(n.realValue), Valid: n.isValid}
+}
+
+// GormValue implements the driver Valuer interface via GORM, so NaN and
+// Infinity are refused before reaching a dialect which can't store them
+func (n Float32) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	value, err := n.Value()
+	if err == nil && value != nil {
+		err = checkNonFiniteSQL(value.(float64), db.Dialector.Name())
+	}
+	if err != nil {
+		db.AddError(err)
+		return clause.Expr{}
+	}
+	return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Float32) JSONSchema() map[string]interface{} {
+	return floatSchema(map[string]interface{}{
+		"type":   []string{"number", "null"},
+		"format": "float",
+	})
+}
+
 // GormDataType gorm common data type
 func (Float32) GormDataType() string {
 	return "float32_null"
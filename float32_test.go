@@ -1,6 +1,12 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -89,6 +95,193 @@ func TestJSONFloat32(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewFloat32(nil))
 }
 
+func TestNaNFloat32(t *testing.T) {
+	nan := float32(math.NaN())
+
+	if _, err := json.Marshal(nullable.NewFloat32(&nan)); !errors.Is(err, nullable.ErrNonFinite) {
+		t.Errorf("Expected ErrNonFinite while marshalling NaN, got %v", err)
+	}
+
+	nullable.SetNaNPolicy(nullable.NaNString)
+	defer nullable.SetNaNPolicy(nullable.NaNError)
+
+	serialized, _ := json.Marshal(nullable.NewFloat32(&nan))
+	tests.AssertEqual(t, string(serialized), `"NaN"`)
+
+	var fromString nullable.Float32
+	json.Unmarshal([]byte(`"-Infinity"`), &fromString)
+	tests.AssertEqual(t, math.IsInf(float64(*fromString.Get()), -1), true)
+
+	// Only the exact strings written by MarshalJSON are accepted
+	for _, input := range []string{`"nan"`, `"inf"`, `"+Inf"`, `"infinity"`, `"-Inf"`, `"1.5"`} {
+		var refused nullable.Float32
+		if err := json.Unmarshal([]byte(input), &refused); err == nil {
+			t.Errorf("Expected error while unmarshalling %s", input)
+		}
+	}
+}
+
+func TestGQLFloat32(t *testing.T) {
+	var basicFloat1 float32 = 24.78
+	marshalUnmarshalGQL(t, nullable.NewFloat32(&basicFloat1))
+
+	var basicFloat2 float32 = -24.78
+	marshalUnmarshalGQL(t, nullable.NewFloat32(&basicFloat2))
+
+	var basicFloat3 float32 = 782.873129836256643728346128238420
+	marshalUnmarshalGQL(t, nullable.NewFloat32(&basicFloat3))
+
+	var basicFloat4 float32 = -782.873129836256643728346128238420
+	marshalUnmarshalGQL(t, nullable.NewFloat32(&basicFloat4))
+
+	marshalUnmarshalGQL(t, nullable.NewFloat32(nil))
+}
+
+func TestTextFloat32(t *testing.T) {
+	var basicFloat1 float32 = 24.78
+	marshalUnmarshalText(t, nullable.NewFloat32(&basicFloat1))
+
+	var basicFloat2 float32 = -24.78
+	marshalUnmarshalText(t, nullable.NewFloat32(&basicFloat2))
+
+	var basicFloat3 float32 = 782.873129836256643728346128238420
+	marshalUnmarshalText(t, nullable.NewFloat32(&basicFloat3))
+
+	var basicFloat4 float32 = -782.873129836256643728346128238420
+	marshalUnmarshalText(t, nullable.NewFloat32(&basicFloat4))
+
+	marshalUnmarshalText(t, nullable.NewFloat32(nil))
+}
+
+func TestBinaryFloat32(t *testing.T) {
+	var basicFloat1 float32 = 24.78
+	marshalUnmarshalBinary(t, nullable.NewFloat32(&basicFloat1))
+
+	var basicFloat2 float32 = -24.78
+	marshalUnmarshalBinary(t, nullable.NewFloat32(&basicFloat2))
+
+	var basicFloat3 float32 = 782.873129836256643728346128238420
+	marshalUnmarshalBinary(t, nullable.NewFloat32(&basicFloat3))
+
+	var basicFloat4 float32 = -782.873129836256643728346128238420
+	marshalUnmarshalBinary(t, nullable.NewFloat32(&basicFloat4))
+
+	marshalUnmarshalBinary(t, nullable.NewFloat32(nil))
+}
+
+func TestAccessorFloat32(t *testing.T) {
+	nullableValue := nullable.NewFloat32Value(float32(1.5))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(float32(2)), float32(1.5))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), float32(1.5))
+	tests.AssertEqual(t, nullableValue.MustGet(), float32(1.5))
+	tests.AssertEqual(t, nullableValue.Map(func(v float32) float32 { return v * 2 }).MustGet(), float32(3))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(float32) nullable.Float32 { return nullable.NewFloat32(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(float32) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(float32) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Float32](float32(1.5)), nullableValue)
+
+	nullValue := nullable.NewFloat32(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(float32(2)), float32(2))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), float32(0))
+	tests.AssertEqual(t, nullValue.Map(func(v float32) float32 { return v * 2 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(float32) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullFloat32(t *testing.T) {
+	converted, err := nullable.FromSQLNullFloat32(sql.NullFloat64{Float64: 1.5, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullFloat64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 1.5)
+
+	converted, err = nullable.FromSQLNullFloat32(sql.NullFloat64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewFloat32Value(1.5).SQLNull(), sql.NullFloat64{Float64: 1.5, Valid: true})
+	tests.AssertEqual(t, nullable.NewFloat32(nil).SQLNull(), sql.NullFloat64{})
+
+	if _, err := nullable.FromSQLNullFloat32(sql.NullFloat64{Float64: 1e300, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting 1e300 to Float32, got %v", err)
+	}
+}
+
+func TestArithmeticFloat32(t *testing.T) {
+	a, b := nullable.NewFloat32Value(7.5), nullable.NewFloat32Value(-2.5)
+	null := nullable.NewFloat32(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), float32(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), float32(10))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), float32(-18.75))
+	tests.AssertEqual(t, b.Neg().MustGet(), float32(2.5))
+	tests.AssertEqual(t, b.Abs().MustGet(), float32(2.5))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Neg().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), float32(-3))
+
+	quotient, err = a.Div(nullable.NewFloat32Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewFloat32Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareFloat32(t *testing.T) {
+	low, high := nullable.NewFloat32Value(float32(-1.5)), nullable.NewFloat32Value(float32(1.5))
+	null := nullable.NewFloat32(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewFloat32Value(float32(-1.5)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewFloat32(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewFloat32Value(float32(-1.5))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewFloat32(nil)), true)
+
+	// Equal agrees with Compare, so NaN equals NaN
+	nan := nullable.NewFloat32Value(float32(math.NaN()))
+	tests.AssertEqual(t, nan.Compare(nullable.NewFloat32Value(float32(math.NaN())), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, nan.Equal(nullable.NewFloat32Value(float32(math.NaN()))), true)
+	tests.AssertEqual(t, nan.Equal(low), false)
+}
+
+func TestFormatFloat32(t *testing.T) {
+	value := nullable.NewFloat32Value(1.5)
+	null := nullable.NewFloat32(nil)
+	tests.AssertEqual(t, value.String(), "1.5")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `1.5|"1.5"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "1.5")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewFloat32Value(1.5)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewFloat32(nil)")
+}
+
+func TestLogValueFloat32(t *testing.T) {
+	value := nullable.NewFloat32Value(1.5).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindFloat64)
+	tests.AssertEqual(t, value.Float64(), 1.5)
+
+	null := nullable.NewFloat32(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestFloat32(t *testing.T) {
 	type TestNullableFloat32 struct {
 		ID        uint
@@ -1,10 +1,20 @@
 package nullable
 
 import (
+	"cmp"
+	"context"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
 )
 
@@ -28,6 +38,14 @@ func NewFloat64(value *float64) Float64 {
 	}
 }
 
+// NewFloat64Value creates a new valid nullable double precision float from plain value
+func NewFloat64Value(value float64) Float64 {
+	return Float64{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or double precision float
 func (n Float64) Get() *float64 {
 	if !n.isValid {
@@ -46,48 +64,372 @@ func (n *Float64) Set(value *float64) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Float64) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Float64) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Float64) ValueOr(fallback float64) float64 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Float64) ValueOrZero() float64 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Float64) MustGet() float64 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Float64")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Float64) Clone() Float64 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Float64) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatFloat(n.realValue, 'g', -1, 64)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Float64) GoString() string {
+	if !n.isValid {
+		return "nullable.NewFloat64(nil)"
+	}
+	return fmt.Sprintf("nullable.NewFloat64Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Float64) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Float64) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Float64Value(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Float64) Map(fn func(float64) float64) Float64 {
+	if !n.isValid {
+		return n
+	}
+	return NewFloat64Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Float64) FlatMap(fn func(float64) Float64) Float64 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Float64) Filter(fn func(float64) bool) Float64 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewFloat64(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes, floats are compared with cmp.Compare, so NaN is the smallest
+func (n Float64) Compare(other Float64, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value, NaN equals NaN like Compare
+func (n Float64) Equal(other Float64) bool {
+	return n.isValid == other.isValid && (!n.isValid || cmp.Compare(n.realValue, other.realValue) == 0)
+}
+
+// Add returns n + other, NULL when either is NULL
+func (n Float64) Add(other Float64) Float64 {
+	if !n.isValid || !other.isValid {
+		return NewFloat64(nil)
+	}
+	return NewFloat64Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL
+func (n Float64) Sub(other Float64) Float64 {
+	if !n.isValid || !other.isValid {
+		return NewFloat64(nil)
+	}
+	return NewFloat64Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL
+func (n Float64) Mul(other Float64) Float64 {
+	if !n.isValid || !other.isValid {
+		return NewFloat64(nil)
+	}
+	return NewFloat64Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Float64) Div(other Float64) (Float64, error) {
+	if !n.isValid || !other.isValid {
+		return NewFloat64(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewFloat64(nil), divisionByZero()
+	}
+	return NewFloat64Value(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL
+func (n Float64) Neg() Float64 {
+	if !n.isValid {
+		return n
+	}
+	return NewFloat64Value(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL
+func (n Float64) Abs() Float64 {
+	if !n.isValid {
+		return n
+	}
+	return NewFloat64Value(math.Abs(n.realValue))
+}
+
+// MarshalJSON converts current value to JSON, see SetNaNPolicy for NaN and Infinity
 func (n Float64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if n.isValid && isNonFinite(n.realValue) {
+		return marshalNonFiniteJSON(n.realValue)
+	}
+	return n.AppendJSON(nil), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// AppendJSON appends JSON form of current value to dst without reflection.
+// NaN and Infinity refused by SetNaNPolicy become null, MarshalJSON returns error instead.
+func (n Float64) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	if isNonFinite(n.realValue) {
+		return appendNonFiniteJSON(dst, n.realValue)
+	}
+	return appendJSONFloat(dst, n.realValue, 64)
+}
+
+// UnmarshalJSON writes JSON to this type, see SetNaNPolicy for NaN and Infinity, failures are *DecodeError
 func (n *Float64) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Float64) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
 	var parsed float64
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if isLenientJSON() {
+		var scanned Float64
+		if err := readLenientJSON(&scanned, data); err != nil {
+			return err
+		}
+		parsed = scanned.realValue
+	} else if data[0] == '"' {
+		nonFinite, err := parseNonFiniteJSON(data)
+		if err != nil {
+			return err
+		}
+		parsed = nonFinite
+	} else if fast, isFast := parseJSONFloat(data, 64); isFast {
+		parsed = fast
+	} else {
+		var slow float64
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	isValid, err := checkNonFinite(parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		parsed = 0
+	}
+
+	n.isValid = isValid
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Float64) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendFloat(nil, n.realValue, 'g', -1, 64), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Float64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Float64) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Float64) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Float64) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, 8)
+	if n.isValid {
+		var encoded [8]byte
+		binary.BigEndian.PutUint64(encoded[:], math.Float64bits(n.realValue))
+		buffer = append(buffer, encoded[:]...)
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Float64) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Float64")
+	if err != nil {
 		return err
 	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	if err := readFixed(payload, 8, "Float64"); err != nil {
+		return err
+	}
+	parsed := math.Float64frombits(binary.BigEndian.Uint64(payload))
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Float64) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Float64) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Float64) Scan(value interface{}) error {
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
+
+	parsed, err := scanFloat(value, 64, n)
+	if err != nil {
+		return err
+	}
+	n.realValue = parsed
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
-// Value implements the driver Valuer interface.
+// Value implements the driver Valuer interface, see SetNaNPolicy for NaN and Infinity
 func (n Float64) Value() (driver.Value, error) {
 	if !n.isValid {
 		return nil, nil
 	}
+
+	isValid, err := checkNonFinite(n.realValue)
+	if err != nil || !isValid {
+		return nil, err
+	}
 	return n.realValue, nil
 }
 
+// FromSQLNullFloat64 converts sql.NullFloat64 to nullable double precision float
+func FromSQLNullFloat64(value sql.NullFloat64) Float64 {
+	if !value.Valid {
+		return NewFloat64(nil)
+	}
+	return NewFloat64Value(value.Float64)
+}
+
+// SQLNull converts to sql.NullFloat64
+func (n Float64) SQLNull() sql.NullFloat64 {
+	return sql.NullFloat64{Float64: n.realValue, Valid: n.isValid}
+}
+
+// GormValue implements the driver Valuer interface via GORM, so NaN and
+// Infinity are refused before reaching a dialect which can't store them
+func (n Float64) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	value, err := n.Value()
+	if err == nil && value != nil {
+		err = checkNonFiniteSQL(value.(float64), db.Dialector.Name())
+	}
+	if err != nil {
+		db.AddError(err)
+		return clause.Expr{}
+	}
+	return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Float64) JSONSchema() map[string]interface{} {
+	return floatSchema(map[string]interface{}{
+		"type":   []string{"number", "null"},
+		"format": "double",
+	})
+}
+
 // GormDataType gorm common data type
 func (Float64) GormDataType() string {
 	return "float64_null"
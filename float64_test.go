@@ -1,6 +1,12 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -89,6 +95,209 @@ func TestJSONFloat64(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewFloat64(nil))
 }
 
+func TestNaNFloat64(t *testing.T) {
+	nan := math.NaN()
+	inf := math.Inf(-1)
+
+	if _, err := json.Marshal(nullable.NewFloat64(&nan)); !errors.Is(err, nullable.ErrNonFinite) {
+		t.Errorf("Expected ErrNonFinite while marshalling NaN, got %v", err)
+	}
+	if _, err := nullable.NewFloat64(&inf).Value(); !errors.Is(err, nullable.ErrNonFinite) {
+		t.Errorf("Expected ErrNonFinite while converting Infinity to SQL, got %v", err)
+	}
+	var refused nullable.Float64
+	if err := json.Unmarshal([]byte(`"NaN"`), &refused); err == nil {
+		t.Error("Expected error while unmarshalling NaN")
+	}
+	if err := json.Unmarshal([]byte(`"1.5"`), &refused); err == nil {
+		t.Error("Expected error while unmarshalling quoted number")
+	}
+
+	nullable.SetNaNPolicy(nullable.NaNNull)
+	defer nullable.SetNaNPolicy(nullable.NaNError)
+
+	serialized, _ := json.Marshal(nullable.NewFloat64(&nan))
+	tests.AssertEqual(t, string(serialized), "null")
+	value, _ := nullable.NewFloat64(&inf).Value()
+	tests.AssertEqual(t, value, nil)
+	var nulled nullable.Float64
+	json.Unmarshal([]byte(`"-Infinity"`), &nulled)
+	tests.AssertEqual(t, nulled.Get(), nil)
+
+	nullable.SetNaNPolicy(nullable.NaNString)
+
+	serialized, _ = json.Marshal(nullable.NewFloat64(&nan))
+	tests.AssertEqual(t, string(serialized), `"NaN"`)
+	serialized, _ = json.Marshal(nullable.NewFloat64(&inf))
+	tests.AssertEqual(t, string(serialized), `"-Infinity"`)
+	value, _ = nullable.NewFloat64(&inf).Value()
+	tests.AssertEqual(t, value, inf)
+
+	var fromString nullable.Float64
+	json.Unmarshal([]byte(`"Infinity"`), &fromString)
+	tests.AssertEqual(t, math.IsInf(*fromString.Get(), 1), true)
+	json.Unmarshal([]byte(`"NaN"`), &fromString)
+	tests.AssertEqual(t, math.IsNaN(*fromString.Get()), true)
+
+	// Only the exact strings written by MarshalJSON are accepted
+	for _, input := range []string{`"nan"`, `"inf"`, `"+Inf"`, `"infinity"`, `"-Inf"`, `"1.5"`} {
+		var refused nullable.Float64
+		if err := json.Unmarshal([]byte(input), &refused); err == nil {
+			t.Errorf("Expected error while unmarshalling %s", input)
+		}
+	}
+}
+
+func TestGQLFloat64(t *testing.T) {
+	var basicFloat1 float64 = 24.78
+	marshalUnmarshalGQL(t, nullable.NewFloat64(&basicFloat1))
+
+	var basicFloat2 float64 = -24.78
+	marshalUnmarshalGQL(t, nullable.NewFloat64(&basicFloat2))
+
+	var basicFloat3 float64 = 782.873129836256643728346128238420
+	marshalUnmarshalGQL(t, nullable.NewFloat64(&basicFloat3))
+
+	var basicFloat4 float64 = -782.873129836256643728346128238420
+	marshalUnmarshalGQL(t, nullable.NewFloat64(&basicFloat4))
+
+	marshalUnmarshalGQL(t, nullable.NewFloat64(nil))
+}
+
+func TestTextFloat64(t *testing.T) {
+	var basicFloat1 float64 = 24.78
+	marshalUnmarshalText(t, nullable.NewFloat64(&basicFloat1))
+
+	var basicFloat2 float64 = -24.78
+	marshalUnmarshalText(t, nullable.NewFloat64(&basicFloat2))
+
+	var basicFloat3 float64 = 782.873129836256643728346128238420
+	marshalUnmarshalText(t, nullable.NewFloat64(&basicFloat3))
+
+	var basicFloat4 float64 = -782.873129836256643728346128238420
+	marshalUnmarshalText(t, nullable.NewFloat64(&basicFloat4))
+
+	marshalUnmarshalText(t, nullable.NewFloat64(nil))
+}
+
+func TestBinaryFloat64(t *testing.T) {
+	var basicFloat1 float64 = 24.78
+	marshalUnmarshalBinary(t, nullable.NewFloat64(&basicFloat1))
+
+	var basicFloat2 float64 = -24.78
+	marshalUnmarshalBinary(t, nullable.NewFloat64(&basicFloat2))
+
+	var basicFloat3 float64 = 782.873129836256643728346128238420
+	marshalUnmarshalBinary(t, nullable.NewFloat64(&basicFloat3))
+
+	var basicFloat4 float64 = -782.873129836256643728346128238420
+	marshalUnmarshalBinary(t, nullable.NewFloat64(&basicFloat4))
+
+	marshalUnmarshalBinary(t, nullable.NewFloat64(nil))
+}
+
+func TestAccessorFloat64(t *testing.T) {
+	nullableValue := nullable.NewFloat64Value(float64(1.5))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(float64(2)), float64(1.5))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), float64(1.5))
+	tests.AssertEqual(t, nullableValue.MustGet(), float64(1.5))
+	tests.AssertEqual(t, nullableValue.Map(func(v float64) float64 { return v * 2 }).MustGet(), float64(3))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(float64) nullable.Float64 { return nullable.NewFloat64(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(float64) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(float64) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Float64](float64(1.5)), nullableValue)
+
+	nullValue := nullable.NewFloat64(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(float64(2)), float64(2))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), float64(0))
+	tests.AssertEqual(t, nullValue.Map(func(v float64) float64 { return v * 2 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(float64) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullFloat64(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullFloat64(sql.NullFloat64{Float64: 1.5, Valid: true}).Get(), 1.5)
+	tests.AssertEqual(t, nullable.FromSQLNullFloat64(sql.NullFloat64{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewFloat64Value(1.5).SQLNull(), sql.NullFloat64{Float64: 1.5, Valid: true})
+	tests.AssertEqual(t, nullable.NewFloat64(nil).SQLNull(), sql.NullFloat64{})
+}
+
+func TestArithmeticFloat64(t *testing.T) {
+	a, b := nullable.NewFloat64Value(7.5), nullable.NewFloat64Value(-2.5)
+	null := nullable.NewFloat64(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), float64(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), float64(10))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), float64(-18.75))
+	tests.AssertEqual(t, b.Neg().MustGet(), float64(2.5))
+	tests.AssertEqual(t, b.Abs().MustGet(), float64(2.5))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Neg().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), float64(-3))
+
+	quotient, err = a.Div(nullable.NewFloat64Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewFloat64Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareFloat64(t *testing.T) {
+	low, high := nullable.NewFloat64Value(float64(-1.5)), nullable.NewFloat64Value(float64(1.5))
+	null := nullable.NewFloat64(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewFloat64Value(float64(-1.5)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewFloat64(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewFloat64Value(float64(-1.5))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewFloat64(nil)), true)
+
+	// Equal agrees with Compare, so NaN equals NaN
+	nan := nullable.NewFloat64Value(float64(math.NaN()))
+	tests.AssertEqual(t, nan.Compare(nullable.NewFloat64Value(float64(math.NaN())), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, nan.Equal(nullable.NewFloat64Value(float64(math.NaN()))), true)
+	tests.AssertEqual(t, nan.Equal(low), false)
+}
+
+func TestFormatFloat64(t *testing.T) {
+	value := nullable.NewFloat64Value(1.5)
+	null := nullable.NewFloat64(nil)
+	tests.AssertEqual(t, value.String(), "1.5")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `1.5|"1.5"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "1.5")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewFloat64Value(1.5)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewFloat64(nil)")
+}
+
+func TestLogValueFloat64(t *testing.T) {
+	value := nullable.NewFloat64Value(1.5).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindFloat64)
+	tests.AssertEqual(t, value.Float64(), 1.5)
+
+	null := nullable.NewFloat64(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestFloat64(t *testing.T) {
 	type TestNullableFloat64 struct {
 		ID        uint
@@ -145,3 +354,45 @@ func TestFloat64(t *testing.T) {
 	}
 	tests.AssertEqual(t, result3, user3)
 }
+
+func TestNaNFloat64GORM(t *testing.T) {
+	type TestNaNFloat64 struct {
+		ID    uint
+		Name  string
+		Ratio nullable.Float64
+	}
+
+	nullable.SetNaNPolicy(nullable.NaNString)
+	defer nullable.SetNaNPolicy(nullable.NaNError)
+
+	DB.Migrator().DropTable(&TestNaNFloat64{})
+	if err := DB.Migrator().AutoMigrate(&TestNaNFloat64{}); err != nil {
+		t.Errorf("failed to migrate NaN float64, got error: %v", err)
+	}
+
+	nan := math.NaN()
+	err := DB.Create(&TestNaNFloat64{Name: "nan", Ratio: nullable.NewFloat64(&nan)}).Error
+	if SupportedDriver("postgres") {
+		if err != nil {
+			t.Errorf("Failed to store NaN because: %s", err)
+		}
+	} else if !errors.Is(err, nullable.ErrNonFinite) {
+		t.Errorf("Expected ErrNonFinite while storing NaN, got %v", err)
+	}
+
+	if SupportedDriver("mysql") {
+		return
+	}
+
+	inf := math.Inf(1)
+	record := TestNaNFloat64{Name: "inf", Ratio: nullable.NewFloat64(&inf)}
+	if err := DB.Create(&record).Error; err != nil {
+		t.Fatalf("Failed to store Infinity because: %s", err)
+	}
+
+	var result TestNaNFloat64
+	if err := DB.First(&result, "name = ?", "inf").Error; err != nil {
+		t.Fatal("Cannot read NaN float64 test record of \"inf\"")
+	}
+	tests.AssertEqual(t, result, record)
+}
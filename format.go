@@ -0,0 +1,56 @@
+package nullable
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync/atomic"
+)
+
+const defaultNullPlaceholder = "NULL"
+
+var nullPlaceholder atomic.Value
+
+// SetNullPlaceholder changes the text of NULL in String and fmt verbs, like "<nil>" or "-".
+// Calling it with empty text restores "NULL".
+func SetNullPlaceholder(placeholder string) {
+	if placeholder == "" {
+		placeholder = defaultNullPlaceholder
+	}
+	nullPlaceholder.Store(placeholder)
+}
+
+func currentNullPlaceholder() string {
+	if placeholder, isSet := nullPlaceholder.Load().(string); isSet {
+		return placeholder
+	}
+	return defaultNullPlaceholder
+}
+
+// formattable is a nullable type which prints itself by String and GoString
+type formattable interface {
+	fmt.Stringer
+	fmt.GoStringer
+}
+
+// formatNullable implements fmt.Formatter: %#v is Go syntax, %s and %q are String,
+// other verbs apply to the value, and NULL is the placeholder with the width and '-' flag of the verb
+func formatNullable(f fmt.State, verb rune, isValid bool, value interface{}, self formattable) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		io.WriteString(f, self.GoString())
+	case verb == 's' || verb == 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), self.String())
+	case !isValid:
+		format := "%"
+		if f.Flag('-') {
+			format += "-"
+		}
+		if width, hasWidth := f.Width(); hasWidth {
+			format += strconv.Itoa(width)
+		}
+		fmt.Fprintf(f, format+"s", currentNullPlaceholder())
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), value)
+	}
+}
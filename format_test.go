@@ -0,0 +1,44 @@
+package nullable_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestFormatVerbs(t *testing.T) {
+	tests.AssertEqual(t, fmt.Sprintf("%5.2f", nullable.NewFloat64Value(3.14159)), " 3.14")
+	tests.AssertEqual(t, fmt.Sprintf("%x", nullable.NewInt64Value(255)), "ff")
+	tests.AssertEqual(t, fmt.Sprintf("%x", nullable.NewBytesValue([]byte{0xca, 0xfe})), "cafe")
+	tests.AssertEqual(t, fmt.Sprintf("%q", nullable.NewStringValue("thor")), `"thor"`)
+	tests.AssertEqual(t, fmt.Sprintf("%05d", nullable.NewUint16Value(42)), "00042")
+	tests.AssertEqual(t, fmt.Sprintf("%5.2f", nullable.NewFloat64(nil)), " NULL")
+}
+
+func TestFormatStruct(t *testing.T) {
+	type user struct {
+		Name nullable.String
+		Age  nullable.Uint8
+	}
+	tests.AssertEqual(t, fmt.Sprintf("%v", user{nullable.NewStringValue("thor"), nullable.NewUint8(nil)}), "{thor NULL}")
+	tests.AssertEqual(t, fmt.Sprintf("%+v", user{nullable.NewStringValue("thor"), nullable.NewUint8Value(30)}), "{Name:thor Age:30}")
+}
+
+func TestFormatCompanion(t *testing.T) {
+	value := nullable.NewInt64String(nil)
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.Int64String{Int64: nullable.NewInt64(nil)}")
+	tests.AssertEqual(t, fmt.Sprint(nullable.IntString{Int: nullable.NewIntValue(7)}), "7")
+}
+
+func TestNullPlaceholder(t *testing.T) {
+	nullable.SetNullPlaceholder("<nil>")
+	defer nullable.SetNullPlaceholder("")
+
+	tests.AssertEqual(t, nullable.NewInt64(nil).String(), "<nil>")
+	tests.AssertEqual(t, fmt.Sprintf("%d", nullable.NewInt64(nil)), "<nil>")
+
+	nullable.SetNullPlaceholder("")
+	tests.AssertEqual(t, nullable.NewInt64(nil).String(), "NULL")
+}
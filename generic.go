@@ -0,0 +1,46 @@
+package nullable
+
+// Nullable is implemented by every nullable type of this package, T is the plain Go type
+type Nullable[T any] interface {
+	Get() *T
+}
+
+// settable constrains pointer to nullable type N which holds T, so generic functions can create N
+type settable[N any, T any] interface {
+	*N
+	Set(value *T)
+}
+
+// Of creates a new valid nullable type N from plain value, like Of[String]("x")
+func Of[N any, T any, P settable[N, T]](value T) N {
+	var result N
+	P(&result).Set(&value)
+	return result
+}
+
+// Map converts valid value of n with fn into another nullable type M, NULL stays NULL.
+// For example, Map[Int64](name, func(v string) int64 { return int64(len(v)) })
+func Map[M any, T any, U any, P settable[M, U]](n Nullable[T], fn func(T) U) M {
+	var result M
+	if value := n.Get(); value != nil {
+		mapped := fn(*value)
+		P(&result).Set(&mapped)
+	}
+	return result
+}
+
+// FlatMap converts valid value of n with fn which may return NULL, NULL stays NULL
+func FlatMap[M any, T any](n Nullable[T], fn func(T) M) M {
+	var result M
+	if value := n.Get(); value != nil {
+		result = fn(*value)
+	}
+	return result
+}
+
+// FromPtr creates nullable type N from pointer, nil becomes NULL, like FromPtr[String](p)
+func FromPtr[N any, T any, P settable[N, T]](value *T) N {
+	var result N
+	P(&result).Set(value)
+	return result
+}
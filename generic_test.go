@@ -0,0 +1,47 @@
+package nullable_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestGenericMap(t *testing.T) {
+	name := nullable.NewStringValue("thor")
+	length := nullable.Map[nullable.Int64](name, func(v string) int64 { return int64(len(v)) })
+	tests.AssertEqual(t, length, nullable.NewInt64Value(4))
+
+	length = nullable.Map[nullable.Int64](nullable.NewString(nil), func(v string) int64 { return int64(len(v)) })
+	tests.AssertEqual(t, length.IsNull(), true)
+}
+
+func TestGenericFlatMap(t *testing.T) {
+	parse := func(v string) nullable.Int64 {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nullable.NewInt64(nil)
+		}
+		return nullable.NewInt64Value(parsed)
+	}
+
+	tests.AssertEqual(t, nullable.FlatMap(nullable.NewStringValue("42"), parse), nullable.NewInt64Value(42))
+	tests.AssertEqual(t, nullable.FlatMap(nullable.NewStringValue("abc"), parse).IsNull(), true)
+	tests.AssertEqual(t, nullable.FlatMap(nullable.NewString(nil), parse).IsNull(), true)
+}
+
+func TestMustGetNull(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic while calling MustGet on NULL")
+		}
+	}()
+	nullable.NewUint8(nil).MustGet()
+}
+
+func TestGenericFromPtr(t *testing.T) {
+	name := "thor"
+	tests.AssertEqual(t, nullable.FromPtr[nullable.String](&name), nullable.NewStringValue("thor"))
+	tests.AssertEqual(t, nullable.FromPtr[nullable.String]((*string)(nil)).IsNull(), true)
+}
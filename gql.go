@@ -0,0 +1,69 @@
+package nullable
+
+//go:generate go run ./internal/cmd/gengraphqls -o nullable.graphqls
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+)
+
+// errFractionalGQL means a GraphQL float literal has fraction, but the target is integer
+var errFractionalGQL = errors.New("fractional number into integer")
+
+// gqlTarget is implemented by every nullable type
+type gqlTarget interface {
+	Scan(value interface{}) error
+	UnmarshalText(text []byte) error
+}
+
+// writeGQL writes JSON form of the value, gqlgen can't handle error so it falls back to null
+func writeGQL(w io.Writer, marshaler json.Marshaler) {
+	data, err := marshaler.MarshalJSON()
+	if err != nil {
+		data = []byte("null")
+	}
+	w.Write(data)
+}
+
+// readGQL writes loosely typed gqlgen input to target. Text inputs, including
+// json.Number from variables, are parsed like plain text while native Go values
+// are converted like SQL scanning.
+func readGQL(target gqlTarget, value interface{}) error {
+	switch typed := value.(type) {
+	case string:
+		return target.UnmarshalText([]byte(typed))
+	case json.Number:
+		return target.UnmarshalText([]byte(typed))
+	case float64:
+		if isIntegerGQL(target) {
+			return readGQLInteger(target, typed)
+		}
+	}
+	return target.Scan(value)
+}
+
+// isIntegerGQL reports whether target only holds integers
+func isIntegerGQL(target gqlTarget) bool {
+	switch target.(type) {
+	case *Int, *Int8, *Int16, *Int32, *Int64, *Uint, *Uint8, *Uint16, *Uint32, *Uint64:
+		return true
+	}
+	return false
+}
+
+// readGQLInteger writes integral float, which gqlgen gives for some numeric literals,
+// to integer target. Scanning the float would format 1e6 as "1e+06" and fail.
+func readGQLInteger(target gqlTarget, value float64) error {
+	const twoTo63, twoTo64 = 1 << 63, 1 << 64
+	switch {
+	case value != math.Trunc(value):
+		return newScanError(value, target, errFractionalGQL)
+	case value >= -twoTo63 && value < twoTo63:
+		return target.Scan(int64(value))
+	case value >= 0 && value < twoTo64:
+		return target.Scan(uint64(value))
+	}
+	return newOverflowError(value, target)
+}
@@ -0,0 +1,132 @@
+package nullable_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v interface{}) error
+}
+
+func marshalUnmarshalGQL(t *testing.T, target interface{}) {
+	var serialized bytes.Buffer
+	target.(gqlMarshaler).MarshalGQL(&serialized)
+
+	// gqlgen decodes variables with json.Number
+	decoder := json.NewDecoder(&serialized)
+	decoder.UseNumber()
+	var input interface{}
+	if err := decoder.Decode(&input); err != nil {
+		t.Fatalf("Failed to decode GraphQL output of %T because: %s", target, err)
+		return
+	}
+
+	unserialized := reflect.New(reflect.TypeOf(target))
+	if err := unserialized.Interface().(gqlUnmarshaler).UnmarshalGQL(input); err != nil {
+		t.Fatalf("Failed to unmarshal %T from GraphQL because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized.Elem().Interface(), target)
+}
+
+func TestGQLLooseInput(t *testing.T) {
+	var nullableUint64 nullable.Uint64
+	for _, input := range []interface{}{"18446744073709551615", json.Number("18446744073709551615"), uint64(18446744073709551615)} {
+		if err := nullableUint64.UnmarshalGQL(input); err != nil {
+			t.Fatalf("Failed to unmarshal %T into Uint64 because: %s", input, err)
+		}
+		tests.AssertEqual(t, nullableUint64.Get(), uint64(18446744073709551615))
+	}
+
+	nullableUint64.UnmarshalGQL(int64(42))
+	tests.AssertEqual(t, nullableUint64.Get(), 42)
+
+	nullableUint64.UnmarshalGQL(nil)
+	tests.AssertEqual(t, nullableUint64.Get(), nil)
+
+	var nullableByte nullable.Byte
+	nullableByte.UnmarshalGQL(int64(42))
+	tests.AssertEqual(t, nullableByte.Get(), 42)
+
+	var nullableInt8 nullable.Int8
+	nullableInt8.UnmarshalGQL(float64(-42))
+	tests.AssertEqual(t, nullableInt8.Get(), -42)
+
+	var nullableBool nullable.Bool
+	nullableBool.UnmarshalGQL(true)
+	tests.AssertEqual(t, nullableBool.Get(), true)
+
+	var nullableTime nullable.Time
+	nullableTime.UnmarshalGQL(time.Unix(1630922400, 0))
+	tests.AssertEqual(t, nullableTime.Get().Unix(), 1630922400)
+}
+
+func TestGQLFloatInteger(t *testing.T) {
+	var nullableInt64 nullable.Int64
+	if err := nullableInt64.UnmarshalGQL(float64(1e6)); err != nil {
+		t.Fatalf("Failed to unmarshal 1e6 into Int64 because: %s", err)
+	}
+	tests.AssertEqual(t, nullableInt64.Get(), 1000000)
+
+	var nullableUint64 nullable.Uint64
+	if err := nullableUint64.UnmarshalGQL(float64(1e19)); err != nil {
+		t.Fatalf("Failed to unmarshal 1e19 into Uint64 because: %s", err)
+	}
+	tests.AssertEqual(t, nullableUint64.Get(), uint64(1e19))
+
+	var nullableFloat64 nullable.Float64
+	nullableFloat64.UnmarshalGQL(1.5)
+	tests.AssertEqual(t, nullableFloat64.Get(), 1.5)
+
+	if err := nullableInt64.UnmarshalGQL(1.5); !errors.Is(err, nullable.ErrScan) {
+		t.Errorf("Expected scan error while unmarshalling 1.5 into Int64, got %v", err)
+	}
+	var nullableInt8 nullable.Int8
+	for _, outOfRange := range []float64{300, 1e20, math.Inf(1)} {
+		if err := nullableInt8.UnmarshalGQL(outOfRange); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while unmarshalling %v into Int8, got %v", outOfRange, err)
+		}
+	}
+	if err := nullableUint64.UnmarshalGQL(float64(-1)); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while unmarshalling -1 into Uint64, got %v", err)
+	}
+}
+
+func TestGQLInvalidInput(t *testing.T) {
+	var nullableInt8 nullable.Int8
+	var nullableByte nullable.Byte
+	var nullableInt64 nullable.Int64
+	var nullableTime nullable.Time
+
+	invalids := []struct {
+		target gqlUnmarshaler
+		input  interface{}
+	}{
+		{&nullableInt8, "128"},
+		{&nullableInt8, json.Number("1.5")},
+		{&nullableByte, int64(256)},
+		{&nullableInt64, "ten"},
+		{&nullableInt64, true},
+		{&nullableTime, int64(1630922400)},
+	}
+
+	for _, invalid := range invalids {
+		if err := invalid.target.UnmarshalGQL(invalid.input); err == nil {
+			t.Errorf("Expected error while unmarshalling %#v into %T", invalid.input, invalid.target)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Package httpbind decodes URL query and form values into struct fields.
+//
+// Fields are bound with the form tag and parsed through their text
+// unmarshalling, so nullable types accept the same text as their JSON
+// strings, like every layout of nullable.SetTimeLayouts.
+// Absent keys leave their fields untouched, so nullable types stay NULL:
+//
+//	type ListFilter struct {
+//		MinAge nullable.Uint8 `form:"min_age"`
+//		Since  nullable.Time  `form:"since"`
+//	}
+//
+//	var filter ListFilter
+//	err := httpbind.Decode(r.URL.Query(), &filter)
+package httpbind
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/Thor-x86/nullable/internal/fields"
+)
+
+// EmptyMode decides what an empty value like "?min_age=" means
+type EmptyMode int
+
+const (
+	// EmptyNull sets the field to NULL (zero value for plain types), this is the default
+	EmptyNull EmptyMode = iota
+	// EmptyParse hands the empty text to the field's own parsing,
+	// so nullable.String becomes a valid empty string while numbers become NULL
+	EmptyParse
+	// EmptyError rejects empty values with ErrEmpty
+	EmptyError
+)
+
+// ErrEmpty is wrapped by FieldError when an empty value is rejected by EmptyError mode
+var ErrEmpty = errors.New("empty value is not allowed")
+
+// FieldError describes a field which can't be decoded
+type FieldError struct {
+	// Field is the Go path of the field, like "Filter.MinAge"
+	Field string
+	// Key is the name of the query or form key
+	Key string
+	// Value is the rejected input
+	Value string
+	// Err is the reason, either ErrEmpty or the parsing error
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("httpbind: %s=%q (%s): %v", e.Key, e.Value, e.Field, e.Err)
+}
+
+// Unwrap returns the reason, so errors.Is(err, ErrEmpty) works
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors aggregates every field which can't be decoded, so all problems are reported at once
+type Errors []error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Decoder fills struct fields from url.Values, the zero value is ready to use
+type Decoder struct {
+	// Empty decides what an empty value means, default is EmptyNull
+	Empty EmptyMode
+}
+
+// Decode fills dst with default Decoder, see Decoder.Decode
+func Decode(values url.Values, dst interface{}) error {
+	return (&Decoder{}).Decode(values, dst)
+}
+
+// DecodeRequest parses query and form of r, then fills dst with default Decoder
+func DecodeRequest(r *http.Request, dst interface{}) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	return Decode(r.Form, dst)
+}
+
+// Decode fills fields tagged with `form:"name"` from the first value of each key.
+// Absent keys leave their fields untouched. Every failed field is reported in Errors.
+func (d *Decoder) Decode(values url.Values, dst interface{}) error {
+	value, err := fields.Struct(dst)
+	if err != nil {
+		return fmt.Errorf("httpbind: %v", err)
+	}
+
+	var failures Errors
+	err = fields.Walk(value, "form", func(field fields.Field) error {
+		if field.Name == "" {
+			return nil
+		}
+		if !fields.IsText(field.Value.Type()) {
+			return fmt.Errorf("unsupported type %s of field %s", field.Value.Type(), field.Path)
+		}
+
+		given, isGiven := values[field.Name]
+		if !isGiven || len(given) == 0 {
+			return nil
+		}
+
+		text := given[0]
+		if text == "" {
+			switch d.Empty {
+			case EmptyNull:
+				field.Value.Set(reflect.Zero(field.Value.Type()))
+				return nil
+			case EmptyError:
+				failures = append(failures, &FieldError{Field: field.Path, Key: field.Name, Err: ErrEmpty})
+				return nil
+			}
+		}
+
+		if err := fields.SetText(field.Value, text); err != nil {
+			failures = append(failures, &FieldError{Field: field.Path, Key: field.Name, Value: text, Err: err})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("httpbind: %v", err)
+	}
+
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package httpbind_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+	"github.com/Thor-x86/nullable/httpbind"
+	"gorm.io/gorm/utils/tests"
+)
+
+type testPaging struct {
+	Limit  nullable.Uint16 `form:"limit"`
+	Offset uint            `form:"offset"`
+}
+
+type testFilter struct {
+	Paging   testPaging
+	MinAge   nullable.Uint8  `form:"min_age"`
+	Name     nullable.String `form:"name"`
+	Active   nullable.Bool   `form:"active"`
+	Since    nullable.Time   `form:"since"`
+	Ignored  nullable.Int64
+	Discount nullable.Float32 `form:"-"`
+}
+
+func TestDecode(t *testing.T) {
+	values, _ := url.ParseQuery("min_age=&name=&active=true&since=2021-09-06&limit=20&offset=40&Ignored=1")
+
+	var filter testFilter
+	if err := httpbind.Decode(values, &filter); err != nil {
+		t.Fatalf("Failed to decode query because: %s", err)
+	}
+
+	tests.AssertEqual(t, filter.Paging.Limit.Get(), 20)
+	tests.AssertEqual(t, filter.Paging.Offset, 40)
+	tests.AssertEqual(t, filter.MinAge.Get(), nil)
+	tests.AssertEqual(t, filter.Name.Get(), nil)
+	tests.AssertEqual(t, filter.Active.Get(), true)
+	tests.AssertEqual(t, filter.Since.Get().Equal(time.Date(2021, 9, 6, 0, 0, 0, 0, time.UTC)), true)
+	tests.AssertEqual(t, filter.Ignored.Get(), nil)
+	tests.AssertEqual(t, filter.Discount.Get(), nil)
+}
+
+func TestDecodeAbsent(t *testing.T) {
+	var minAge uint8 = 18
+	filter := testFilter{MinAge: nullable.NewUint8(&minAge)}
+	if err := httpbind.Decode(url.Values{}, &filter); err != nil {
+		t.Fatalf("Failed to decode query because: %s", err)
+	}
+	tests.AssertEqual(t, filter.MinAge.Get(), 18)
+	tests.AssertEqual(t, filter.Name.Get(), nil)
+}
+
+func TestDecodeEmptyParse(t *testing.T) {
+	values, _ := url.ParseQuery("min_age=&name=")
+
+	var filter testFilter
+	decoder := httpbind.Decoder{Empty: httpbind.EmptyParse}
+	if err := decoder.Decode(values, &filter); err != nil {
+		t.Fatalf("Failed to decode query because: %s", err)
+	}
+	tests.AssertEqual(t, filter.MinAge.Get(), nil)
+	tests.AssertEqual(t, filter.Name.Get(), "")
+}
+
+func TestDecodeEmptyError(t *testing.T) {
+	values, _ := url.ParseQuery("min_age=&name=thor")
+
+	var filter testFilter
+	decoder := httpbind.Decoder{Empty: httpbind.EmptyError}
+	err := decoder.Decode(values, &filter)
+	if !errors.Is(err.(httpbind.Errors)[0], httpbind.ErrEmpty) {
+		t.Fatalf("Expected httpbind.ErrEmpty, got %v", err)
+	}
+	tests.AssertEqual(t, filter.Name.Get(), "thor")
+}
+
+func TestDecodeErrors(t *testing.T) {
+	values, _ := url.ParseQuery("min_age=256&active=yes&since=yesterday&limit=-1")
+
+	var filter testFilter
+	err := httpbind.Decode(values, &filter)
+
+	var failures httpbind.Errors
+	if !errors.As(err, &failures) {
+		t.Fatalf("Expected httpbind.Errors, got %v", err)
+	}
+	tests.AssertEqual(t, len(failures), 4)
+
+	var fieldErr *httpbind.FieldError
+	if !errors.As(failures[0], &fieldErr) {
+		t.Fatalf("Expected httpbind.FieldError, got %v", failures[0])
+	}
+	tests.AssertEqual(t, fieldErr.Field, "Paging.Limit")
+	tests.AssertEqual(t, fieldErr.Key, "limit")
+	tests.AssertEqual(t, fieldErr.Value, "-1")
+}
+
+func TestDecodeRequest(t *testing.T) {
+	request := httptest.NewRequest("POST", "/users?limit=5", strings.NewReader("name=thor"))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var filter testFilter
+	if err := httpbind.DecodeRequest(request, &filter); err != nil {
+		t.Fatalf("Failed to decode request because: %s", err)
+	}
+	tests.AssertEqual(t, filter.Paging.Limit.Get(), 5)
+	tests.AssertEqual(t, filter.Name.Get(), "thor")
+}
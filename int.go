@@ -1,8 +1,15 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +35,14 @@ func NewInt(value *int) Int {
 	}
 }
 
+// NewIntValue creates a new valid nullable integer from plain value
+func NewIntValue(value int) Int {
+	return Int{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or integer
 func (n Int) Get() *int {
 	if !n.isValid {
@@ -46,38 +61,356 @@ func (n *Int) Set(value *int) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Int) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Int) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Int) ValueOr(fallback int) int {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Int) ValueOrZero() int {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Int) MustGet() int {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Int")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Int) Clone() Int {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Int) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatInt(int64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Int) GoString() string {
+	if !n.isValid {
+		return "nullable.NewInt(nil)"
+	}
+	return fmt.Sprintf("nullable.NewIntValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Int) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Int) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.IntValue(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Int) Map(fn func(int) int) Int {
+	if !n.isValid {
+		return n
+	}
+	return NewIntValue(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Int) FlatMap(fn func(int) Int) Int {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Int) Filter(fn func(int) bool) Int {
+	if !n.isValid || !fn(n.realValue) {
+		return NewInt(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Int) Compare(other Int, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Int) Equal(other Int) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Int) Add(other Int) Int {
+	if !n.isValid || !other.isValid {
+		return NewInt(nil)
+	}
+	return NewIntValue(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Int) Sub(other Int) Int {
+	if !n.isValid || !other.isValid {
+		return NewInt(nil)
+	}
+	return NewIntValue(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Int) Mul(other Int) Int {
+	if !n.isValid || !other.isValid {
+		return NewInt(nil)
+	}
+	return NewIntValue(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Int) Div(other Int) (Int, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewInt(nil), divisionByZero()
+	}
+	return NewIntValue(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL, the minimum wraps around like Go, see NegChecked
+func (n Int) Neg() Int {
+	if !n.isValid {
+		return n
+	}
+	return NewIntValue(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL, the minimum wraps around like Go, see AbsChecked
+func (n Int) Abs() Int {
+	if !n.isValid || n.realValue >= 0 {
+		return n
+	}
+	return NewIntValue(-n.realValue)
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Int) AddChecked(other Int) (Int, error) {
+	return n.checked("+", other, addSigned[int])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Int) SubChecked(other Int) (Int, error) {
+	return n.checked("-", other, subSigned[int])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Int) MulChecked(other Int) (Int, error) {
+	return n.checked("*", other, mulSigned[int])
+}
+
+// DivChecked is Div, but fails with *OverflowError when the minimum is divided by -1
+func (n Int) DivChecked(other Int) (Int, error) {
+	if n.isValid && other.isValid && other.realValue == 0 {
+		return NewInt(nil), divisionByZero()
+	}
+	return n.checked("/", other, divSigned[int])
+}
+
+// NegChecked is Neg, but fails with *OverflowError for the minimum
+func (n Int) NegChecked() (Int, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt(nil), overflowOf(operation{operator: "-", left: n.realValue}, new(Int))
+	}
+	return n.Neg(), nil
+}
+
+// AbsChecked is Abs, but fails with *OverflowError for the minimum
+func (n Int) AbsChecked() (Int, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt(nil), overflowOf(operation{operator: "abs", left: n.realValue}, new(Int))
+	}
+	return n.Abs(), nil
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Int) checked(operator string, other Int, fn func(a, b int) (int, bool)) (Int, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewInt(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Int))
+	}
+	return NewIntValue(result), nil
+}
+
+// MarshalJSON converts current value to JSON, see SetLargeIntJSON
 func (n Int) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Int) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeInt(dst, int64(n.realValue), isLargeIntAsString())
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, both number and quoted number are accepted, failures are *DecodeError
 func (n *Int) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Int) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
+	digits, isQuoted := unquoteLargeInt(data)
 	var parsed int
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if fast, isFast := parseJSONInt(digits, strconv.IntSize); isFast {
+		parsed = int(fast)
+	} else if isQuoted {
+		quoted, err := parseQuotedInt(digits, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+		parsed = int(quoted)
+	} else {
+		var slow int
+		if err := json.Unmarshal(digits, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Int) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Int) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, strconv.IntSize)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Int) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Int) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Int) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendVarint(buffer, int64(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Int) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Int")
+	if err != nil {
 		return err
 	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	decoded, err := readVarint(payload, strconv.IntSize, "Int")
+	if err != nil {
+		return err
+	}
+	parsed := int(decoded)
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Int) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Int) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Int) Scan(value interface{}) error {
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
+
+	parsed, err := scanInt(value, strconv.IntSize, n)
+	if err != nil {
+		return err
+	}
+	n.realValue = int(parsed)
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -88,6 +421,31 @@ func (n Int) Value() (driver.Value, error) {
 	return int64(n.realValue), nil
 }
 
+// FromSQLNullInt converts sql.NullInt64 to nullable integer, fails with *OverflowError when out of range
+func FromSQLNullInt(value sql.NullInt64) (Int, error) {
+	if !value.Valid {
+		return NewInt(nil), nil
+	}
+	v := value.Int64
+	if int64(int(v)) != v {
+		return NewInt(nil), overflowOf(v, new(Int))
+	}
+	return NewIntValue(int(v)), nil
+}
+
+// SQLNull converts to sql.NullInt64
+func (n Int) SQLNull() sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(n.realValue), Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Int) JSONSchema() map[string]interface{} {
+	return largeIntSchema(map[string]interface{}{
+		"type":   []string{"integer", "null"},
+		"format": "int64",
+	}, isLargeIntAsString())
+}
+
 // GormDataType gorm common data type
 func (Int) GormDataType() string {
 	return "int_null"
@@ -103,3 +461,51 @@ func (Int) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// IntString is Int which is always written to JSON as string, like "9007199254740993"
+type IntString struct {
+	Int
+}
+
+// NewIntString creates a new nullable integer which is written to JSON as string
+func NewIntString(value *int) IntString {
+	return IntString{NewInt(value)}
+}
+
+// Clone returns a copy
+func (n IntString) Clone() IntString {
+	return IntString{n.Int.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n IntString) GoString() string {
+	return "nullable.IntString{Int: " + n.Int.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Int.Format
+func (n IntString) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string
+func (n IntString) MarshalJSON() ([]byte, error) {
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON string of current value to dst without reflection
+func (n IntString) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeInt(dst, int64(n.realValue), true)
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n IntString) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (n IntString) JSONSchema() map[string]interface{} {
+	return largeIntSchema(n.Int.JSONSchema(), true)
+}
@@ -1,8 +1,16 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +36,14 @@ func NewInt16(value *int16) Int16 {
 	}
 }
 
+// NewInt16Value creates a new valid nullable 16-bit integer from plain value
+func NewInt16Value(value int16) Int16 {
+	return Int16{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 16-bit integer
 func (n Int16) Get() *int16 {
 	if !n.isValid {
@@ -46,30 +62,334 @@ func (n *Int16) Set(value *int16) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Int16) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Int16) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Int16) ValueOr(fallback int16) int16 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Int16) ValueOrZero() int16 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Int16) MustGet() int16 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Int16")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Int16) Clone() Int16 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Int16) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatInt(int64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Int16) GoString() string {
+	if !n.isValid {
+		return "nullable.NewInt16(nil)"
+	}
+	return fmt.Sprintf("nullable.NewInt16Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Int16) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Int16) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Int64Value(int64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Int16) Map(fn func(int16) int16) Int16 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt16Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Int16) FlatMap(fn func(int16) Int16) Int16 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Int16) Filter(fn func(int16) bool) Int16 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewInt16(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Int16) Compare(other Int16, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Int16) Equal(other Int16) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Int16) Add(other Int16) Int16 {
+	if !n.isValid || !other.isValid {
+		return NewInt16(nil)
+	}
+	return NewInt16Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Int16) Sub(other Int16) Int16 {
+	if !n.isValid || !other.isValid {
+		return NewInt16(nil)
+	}
+	return NewInt16Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Int16) Mul(other Int16) Int16 {
+	if !n.isValid || !other.isValid {
+		return NewInt16(nil)
+	}
+	return NewInt16Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Int16) Div(other Int16) (Int16, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt16(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewInt16(nil), divisionByZero()
+	}
+	return NewInt16Value(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL, the minimum wraps around like Go, see NegChecked
+func (n Int16) Neg() Int16 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt16Value(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL, the minimum wraps around like Go, see AbsChecked
+func (n Int16) Abs() Int16 {
+	if !n.isValid || n.realValue >= 0 {
+		return n
+	}
+	return NewInt16Value(-n.realValue)
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Int16) AddChecked(other Int16) (Int16, error) {
+	return n.checked("+", other, addSigned[int16])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Int16) SubChecked(other Int16) (Int16, error) {
+	return n.checked("-", other, subSigned[int16])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Int16) MulChecked(other Int16) (Int16, error) {
+	return n.checked("*", other, mulSigned[int16])
+}
+
+// DivChecked is Div, but fails with *OverflowError when the minimum is divided by -1
+func (n Int16) DivChecked(other Int16) (Int16, error) {
+	if n.isValid && other.isValid && other.realValue == 0 {
+		return NewInt16(nil), divisionByZero()
+	}
+	return n.checked("/", other, divSigned[int16])
+}
+
+// NegChecked is Neg, but fails with *OverflowError for the minimum
+func (n Int16) NegChecked() (Int16, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt16(nil), overflowOf(operation{operator: "-", left: n.realValue}, new(Int16))
+	}
+	return n.Neg(), nil
+}
+
+// AbsChecked is Abs, but fails with *OverflowError for the minimum
+func (n Int16) AbsChecked() (Int16, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt16(nil), overflowOf(operation{operator: "abs", left: n.realValue}, new(Int16))
+	}
+	return n.Abs(), nil
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Int16) checked(operator string, other Int16, fn func(a, b int16) (int16, bool)) (Int16, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt16(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewInt16(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Int16))
+	}
+	return NewInt16Value(result), nil
+}
+
 // MarshalJSON converts current value to JSON
 func (n Int16) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Int16) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendInt(dst, int64(n.realValue), 10)
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Int16) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Int16) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed int16
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if fast, isFast := parseJSONInt(data, 16); isFast {
+		parsed = int16(fast)
+	} else {
+		var slow int16
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Int16) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Int16) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 16)
+	if err != nil {
 		return err
 	}
 
+	n.isValid = true
+	n.realValue = int16(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Int16) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Int16) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Int16) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendVarint(buffer, int64(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Int16) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Int16")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	decoded, err := readVarint(payload, 16, "Int16")
+	if err != nil {
+		return err
+	}
+	parsed := int16(decoded)
+
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Int16) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Int16) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Int16) Scan(value interface{}) error {
 	if value == nil {
@@ -77,11 +397,11 @@ func (n *Int16) Scan(value interface{}) error {
 		return nil
 	}
 
-	var i64 int64
-	if err := convertAssign(&i64, value); err != nil {
+	parsed, err := scanInt(value, 16, n)
+	if err != nil {
 		return err
 	}
-	n.realValue = int16(i64)
+	n.realValue = int16(parsed)
 
 	n.isValid = true
 	return nil
@@ -95,6 +415,41 @@ func (n Int16) Value() (driver.Value, error) {
 	return int64(n.realValue), nil
 }
 
+// FromSQLNullInt16 converts sql.NullInt16 to nullable 16-bit integer
+func FromSQLNullInt16(value sql.NullInt16) Int16 {
+	if !value.Valid {
+		return NewInt16(nil)
+	}
+	return NewInt16Value(value.Int16)
+}
+
+// FromSQLNullInt64ToInt16 converts sql.NullInt64 to nullable 16-bit integer, fails with *OverflowError when out of range
+func FromSQLNullInt64ToInt16(value sql.NullInt64) (Int16, error) {
+	if !value.Valid {
+		return NewInt16(nil), nil
+	}
+	v := value.Int64
+	if int64(int16(v)) != v {
+		return NewInt16(nil), overflowOf(v, new(Int16))
+	}
+	return NewInt16Value(int16(v)), nil
+}
+
+// SQLNull converts to sql.NullInt16
+func (n Int16) SQLNull() sql.NullInt16 {
+	return sql.NullInt16{Int16: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Int16) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int32",
+		"minimum": math.MinInt16,
+		"maximum": math.MaxInt16,
+	}
+}
+
 // GormDataType gorm common data type
 func (Int16) GormDataType() string {
 	return "int16_null"
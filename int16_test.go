@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -23,6 +28,54 @@ func TestJSONInt16(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt16(nil))
 }
 
+func TestGQLInt16(t *testing.T) {
+	var basicInt1 int16 = 37
+	marshalUnmarshalGQL(t, nullable.NewInt16(&basicInt1))
+
+	var basicInt2 int16 = -37
+	marshalUnmarshalGQL(t, nullable.NewInt16(&basicInt2))
+
+	var basicInt3 int16 = 1234
+	marshalUnmarshalGQL(t, nullable.NewInt16(&basicInt3))
+
+	var basicInt4 int16 = -1234
+	marshalUnmarshalGQL(t, nullable.NewInt16(&basicInt4))
+
+	marshalUnmarshalGQL(t, nullable.NewInt16(nil))
+}
+
+func TestTextInt16(t *testing.T) {
+	var basicInt1 int16 = 37
+	marshalUnmarshalText(t, nullable.NewInt16(&basicInt1))
+
+	var basicInt2 int16 = -37
+	marshalUnmarshalText(t, nullable.NewInt16(&basicInt2))
+
+	var basicInt3 int16 = 1234
+	marshalUnmarshalText(t, nullable.NewInt16(&basicInt3))
+
+	var basicInt4 int16 = -1234
+	marshalUnmarshalText(t, nullable.NewInt16(&basicInt4))
+
+	marshalUnmarshalText(t, nullable.NewInt16(nil))
+}
+
+func TestBinaryInt16(t *testing.T) {
+	var basicInt1 int16 = 37
+	marshalUnmarshalBinary(t, nullable.NewInt16(&basicInt1))
+
+	var basicInt2 int16 = -37
+	marshalUnmarshalBinary(t, nullable.NewInt16(&basicInt2))
+
+	var basicInt3 int16 = 1234
+	marshalUnmarshalBinary(t, nullable.NewInt16(&basicInt3))
+
+	var basicInt4 int16 = -1234
+	marshalUnmarshalBinary(t, nullable.NewInt16(&basicInt4))
+
+	marshalUnmarshalBinary(t, nullable.NewInt16(nil))
+}
+
 func TestNewInt16(t *testing.T) {
 	// uint8
 	var basicInt1 int16 = 37
@@ -73,6 +126,142 @@ func TestSetInt16(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestAccessorInt16(t *testing.T) {
+	nullableValue := nullable.NewInt16Value(int16(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(int16(1)), int16(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), int16(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), int16(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v int16) int16 { return v + 1 }).MustGet(), int16(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(int16) nullable.Int16 { return nullable.NewInt16(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(int16) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(int16) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Int16](int16(37)), nullableValue)
+
+	nullValue := nullable.NewInt16(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(int16(1)), int16(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), int16(0))
+	tests.AssertEqual(t, nullValue.Map(func(v int16) int16 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(int16) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullInt16(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullInt16(sql.NullInt16{Int16: -37, Valid: true}).Get(), -37)
+	tests.AssertEqual(t, nullable.FromSQLNullInt16(sql.NullInt16{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewInt16Value(-37).SQLNull(), sql.NullInt16{Int16: -37, Valid: true})
+	tests.AssertEqual(t, nullable.NewInt16(nil).SQLNull(), sql.NullInt16{})
+}
+
+func TestSQLNullInt64ToInt16(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt64ToInt16(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullInt64ToInt16(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	for _, outOfRange := range []int64{32768, -32769} {
+		if _, err := nullable.FromSQLNullInt64ToInt16(sql.NullInt64{Int64: outOfRange, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while converting %d to Int16, got %v", outOfRange, err)
+		}
+	}
+}
+
+func TestArithmeticInt16(t *testing.T) {
+	a, b := nullable.NewInt16Value(7), nullable.NewInt16Value(-2)
+	null := nullable.NewInt16(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), int16(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), int16(9))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), int16(-14))
+	tests.AssertEqual(t, b.Neg().MustGet(), int16(2))
+	tests.AssertEqual(t, b.Abs().MustGet(), int16(2))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Abs().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), int16(-3))
+
+	max, min := nullable.NewInt16Value(math.MaxInt16), nullable.NewInt16Value(math.MinInt16)
+	one, minusOne := nullable.NewInt16Value(1), nullable.NewInt16Value(-1)
+	tests.AssertEqual(t, max.Add(one), min)
+	overflows := []func() (nullable.Int16, error){
+		func() (nullable.Int16, error) { return max.AddChecked(one) },
+		func() (nullable.Int16, error) { return min.SubChecked(one) },
+		func() (nullable.Int16, error) { return max.MulChecked(b) },
+		func() (nullable.Int16, error) { return min.MulChecked(minusOne) },
+		func() (nullable.Int16, error) { return min.DivChecked(minusOne) },
+		func() (nullable.Int16, error) { return min.NegChecked() },
+		func() (nullable.Int16, error) { return min.AbsChecked() },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	sum, err := a.AddChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum.MustGet(), int16(5))
+
+	quotient, err = a.Div(nullable.NewInt16Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewInt16Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareInt16(t *testing.T) {
+	low, high := nullable.NewInt16Value(int16(-37)), nullable.NewInt16Value(int16(37))
+	null := nullable.NewInt16(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewInt16Value(int16(-37)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewInt16(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewInt16Value(int16(-37))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewInt16(nil)), true)
+}
+
+func TestFormatInt16(t *testing.T) {
+	value := nullable.NewInt16Value(-42)
+	null := nullable.NewInt16(nil)
+	tests.AssertEqual(t, value.String(), "-42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `-42|"-42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "-42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewInt16Value(-42)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewInt16(nil)")
+}
+
+func TestLogValueInt16(t *testing.T) {
+	value := nullable.NewInt16Value(-42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindInt64)
+	tests.AssertEqual(t, value.Int64(), int64(-42))
+
+	null := nullable.NewInt16(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestInt16(t *testing.T) {
 	type TestNullableInt16 struct {
 		ID    uint
@@ -1,8 +1,15 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +35,14 @@ func NewInt32(value *int32) Int32 {
 	}
 }
 
+// NewInt32Value creates a new valid nullable 32-bit integer from plain value
+func NewInt32Value(value int32) Int32 {
+	return Int32{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 32-bit integer
 func (n Int32) Get() *int32 {
 	if !n.isValid {
@@ -46,30 +61,334 @@ func (n *Int32) Set(value *int32) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Int32) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Int32) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Int32) ValueOr(fallback int32) int32 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Int32) ValueOrZero() int32 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Int32) MustGet() int32 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Int32")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Int32) Clone() Int32 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Int32) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatInt(int64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Int32) GoString() string {
+	if !n.isValid {
+		return "nullable.NewInt32(nil)"
+	}
+	return fmt.Sprintf("nullable.NewInt32Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Int32) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Int32) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Int64Value(int64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Int32) Map(fn func(int32) int32) Int32 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt32Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Int32) FlatMap(fn func(int32) Int32) Int32 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Int32) Filter(fn func(int32) bool) Int32 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewInt32(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Int32) Compare(other Int32, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Int32) Equal(other Int32) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Int32) Add(other Int32) Int32 {
+	if !n.isValid || !other.isValid {
+		return NewInt32(nil)
+	}
+	return NewInt32Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Int32) Sub(other Int32) Int32 {
+	if !n.isValid || !other.isValid {
+		return NewInt32(nil)
+	}
+	return NewInt32Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Int32) Mul(other Int32) Int32 {
+	if !n.isValid || !other.isValid {
+		return NewInt32(nil)
+	}
+	return NewInt32Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Int32) Div(other Int32) (Int32, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt32(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewInt32(nil), divisionByZero()
+	}
+	return NewInt32Value(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL, the minimum wraps around like Go, see NegChecked
+func (n Int32) Neg() Int32 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt32Value(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL, the minimum wraps around like Go, see AbsChecked
+func (n Int32) Abs() Int32 {
+	if !n.isValid || n.realValue >= 0 {
+		return n
+	}
+	return NewInt32Value(-n.realValue)
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Int32) AddChecked(other Int32) (Int32, error) {
+	return n.checked("+", other, addSigned[int32])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Int32) SubChecked(other Int32) (Int32, error) {
+	return n.checked("-", other, subSigned[int32])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Int32) MulChecked(other Int32) (Int32, error) {
+	return n.checked("*", other, mulSigned[int32])
+}
+
+// DivChecked is Div, but fails with *OverflowError when the minimum is divided by -1
+func (n Int32) DivChecked(other Int32) (Int32, error) {
+	if n.isValid && other.isValid && other.realValue == 0 {
+		return NewInt32(nil), divisionByZero()
+	}
+	return n.checked("/", other, divSigned[int32])
+}
+
+// NegChecked is Neg, but fails with *OverflowError for the minimum
+func (n Int32) NegChecked() (Int32, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt32(nil), overflowOf(operation{operator: "-", left: n.realValue}, new(Int32))
+	}
+	return n.Neg(), nil
+}
+
+// AbsChecked is Abs, but fails with *OverflowError for the minimum
+func (n Int32) AbsChecked() (Int32, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt32(nil), overflowOf(operation{operator: "abs", left: n.realValue}, new(Int32))
+	}
+	return n.Abs(), nil
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Int32) checked(operator string, other Int32, fn func(a, b int32) (int32, bool)) (Int32, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt32(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewInt32(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Int32))
+	}
+	return NewInt32Value(result), nil
+}
+
 // MarshalJSON converts current value to JSON
 func (n Int32) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Int32) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendInt(dst, int64(n.realValue), 10)
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Int32) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Int32) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed int32
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if fast, isFast := parseJSONInt(data, 32); isFast {
+		parsed = int32(fast)
+	} else {
+		var slow int32
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Int32) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Int32) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
 		return err
 	}
 
+	n.isValid = true
+	n.realValue = int32(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Int32) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Int32) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Int32) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendVarint(buffer, int64(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Int32) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Int32")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	decoded, err := readVarint(payload, 32, "Int32")
+	if err != nil {
+		return err
+	}
+	parsed := int32(decoded)
+
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Int32) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Int32) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Int32) Scan(value interface{}) error {
 	if value == nil {
@@ -77,11 +396,11 @@ func (n *Int32) Scan(value interface{}) error {
 		return nil
 	}
 
-	var i64 int64
-	if err := convertAssign(&i64, value); err != nil {
+	parsed, err := scanInt(value, 32, n)
+	if err != nil {
 		return err
 	}
-	n.realValue = int32(i64)
+	n.realValue = int32(parsed)
 
 	n.isValid = true
 	return nil
@@ -95,6 +414,39 @@ func (n Int32) Value() (driver.Value, error) {
 	return int64(n.realValue), nil
 }
 
+// FromSQLNullInt32 converts sql.NullInt32 to nullable 32-bit integer
+func FromSQLNullInt32(value sql.NullInt32) Int32 {
+	if !value.Valid {
+		return NewInt32(nil)
+	}
+	return NewInt32Value(value.Int32)
+}
+
+// FromSQLNullInt64ToInt32 converts sql.NullInt64 to nullable 32-bit integer, fails with *OverflowError when out of range
+func FromSQLNullInt64ToInt32(value sql.NullInt64) (Int32, error) {
+	if !value.Valid {
+		return NewInt32(nil), nil
+	}
+	v := value.Int64
+	if int64(int32(v)) != v {
+		return NewInt32(nil), overflowOf(v, new(Int32))
+	}
+	return NewInt32Value(int32(v)), nil
+}
+
+// SQLNull converts to sql.NullInt32
+func (n Int32) SQLNull() sql.NullInt32 {
+	return sql.NullInt32{Int32: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Int32) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":   []string{"integer", "null"},
+		"format": "int32",
+	}
+}
+
 // GormDataType gorm common data type
 func (Int32) GormDataType() string {
 	return "int32_null"
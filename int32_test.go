@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -140,6 +145,208 @@ func TestJSONInt32(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt32(nil))
 }
 
+func TestGQLInt32(t *testing.T) {
+	var basicInt1 int32 = 37
+	marshalUnmarshalGQL(t, nullable.NewInt32(&basicInt1))
+
+	var basicInt2 int32 = -37
+	marshalUnmarshalGQL(t, nullable.NewInt32(&basicInt2))
+
+	var basicInt3 int32 = 1234
+	marshalUnmarshalGQL(t, nullable.NewInt32(&basicInt3))
+
+	var basicInt4 int32 = -1234
+	marshalUnmarshalGQL(t, nullable.NewInt32(&basicInt4))
+
+	var basicInt5 int32 = 654321
+	marshalUnmarshalGQL(t, nullable.NewInt32(&basicInt5))
+
+	var basicInt6 int32 = -654321
+	marshalUnmarshalGQL(t, nullable.NewInt32(&basicInt6))
+
+	marshalUnmarshalGQL(t, nullable.NewInt32(nil))
+}
+
+func TestTextInt32(t *testing.T) {
+	var basicInt1 int32 = 37
+	marshalUnmarshalText(t, nullable.NewInt32(&basicInt1))
+
+	var basicInt2 int32 = -37
+	marshalUnmarshalText(t, nullable.NewInt32(&basicInt2))
+
+	var basicInt3 int32 = 1234
+	marshalUnmarshalText(t, nullable.NewInt32(&basicInt3))
+
+	var basicInt4 int32 = -1234
+	marshalUnmarshalText(t, nullable.NewInt32(&basicInt4))
+
+	var basicInt5 int32 = 654321
+	marshalUnmarshalText(t, nullable.NewInt32(&basicInt5))
+
+	var basicInt6 int32 = -654321
+	marshalUnmarshalText(t, nullable.NewInt32(&basicInt6))
+
+	marshalUnmarshalText(t, nullable.NewInt32(nil))
+}
+
+func TestBinaryInt32(t *testing.T) {
+	var basicInt1 int32 = 37
+	marshalUnmarshalBinary(t, nullable.NewInt32(&basicInt1))
+
+	var basicInt2 int32 = -37
+	marshalUnmarshalBinary(t, nullable.NewInt32(&basicInt2))
+
+	var basicInt3 int32 = 1234
+	marshalUnmarshalBinary(t, nullable.NewInt32(&basicInt3))
+
+	var basicInt4 int32 = -1234
+	marshalUnmarshalBinary(t, nullable.NewInt32(&basicInt4))
+
+	var basicInt5 int32 = 654321
+	marshalUnmarshalBinary(t, nullable.NewInt32(&basicInt5))
+
+	var basicInt6 int32 = -654321
+	marshalUnmarshalBinary(t, nullable.NewInt32(&basicInt6))
+
+	marshalUnmarshalBinary(t, nullable.NewInt32(nil))
+}
+
+func TestAccessorInt32(t *testing.T) {
+	nullableValue := nullable.NewInt32Value(int32(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(int32(1)), int32(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), int32(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), int32(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v int32) int32 { return v + 1 }).MustGet(), int32(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(int32) nullable.Int32 { return nullable.NewInt32(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(int32) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(int32) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Int32](int32(37)), nullableValue)
+
+	nullValue := nullable.NewInt32(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(int32(1)), int32(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), int32(0))
+	tests.AssertEqual(t, nullValue.Map(func(v int32) int32 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(int32) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullInt32(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullInt32(sql.NullInt32{Int32: -37, Valid: true}).Get(), -37)
+	tests.AssertEqual(t, nullable.FromSQLNullInt32(sql.NullInt32{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewInt32Value(-37).SQLNull(), sql.NullInt32{Int32: -37, Valid: true})
+	tests.AssertEqual(t, nullable.NewInt32(nil).SQLNull(), sql.NullInt32{})
+}
+
+func TestSQLNullInt64ToInt32(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt64ToInt32(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullInt64ToInt32(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	for _, outOfRange := range []int64{2147483648, -2147483649} {
+		if _, err := nullable.FromSQLNullInt64ToInt32(sql.NullInt64{Int64: outOfRange, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while converting %d to Int32, got %v", outOfRange, err)
+		}
+	}
+}
+
+func TestArithmeticInt32(t *testing.T) {
+	a, b := nullable.NewInt32Value(7), nullable.NewInt32Value(-2)
+	null := nullable.NewInt32(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), int32(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), int32(9))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), int32(-14))
+	tests.AssertEqual(t, b.Neg().MustGet(), int32(2))
+	tests.AssertEqual(t, b.Abs().MustGet(), int32(2))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Abs().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), int32(-3))
+
+	max, min := nullable.NewInt32Value(math.MaxInt32), nullable.NewInt32Value(math.MinInt32)
+	one, minusOne := nullable.NewInt32Value(1), nullable.NewInt32Value(-1)
+	tests.AssertEqual(t, max.Add(one), min)
+	overflows := []func() (nullable.Int32, error){
+		func() (nullable.Int32, error) { return max.AddChecked(one) },
+		func() (nullable.Int32, error) { return min.SubChecked(one) },
+		func() (nullable.Int32, error) { return max.MulChecked(b) },
+		func() (nullable.Int32, error) { return min.MulChecked(minusOne) },
+		func() (nullable.Int32, error) { return min.DivChecked(minusOne) },
+		func() (nullable.Int32, error) { return min.NegChecked() },
+		func() (nullable.Int32, error) { return min.AbsChecked() },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	sum, err := a.AddChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum.MustGet(), int32(5))
+
+	quotient, err = a.Div(nullable.NewInt32Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewInt32Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareInt32(t *testing.T) {
+	low, high := nullable.NewInt32Value(int32(-37)), nullable.NewInt32Value(int32(37))
+	null := nullable.NewInt32(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewInt32Value(int32(-37)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewInt32(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewInt32Value(int32(-37))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewInt32(nil)), true)
+}
+
+func TestFormatInt32(t *testing.T) {
+	value := nullable.NewInt32Value(-42)
+	null := nullable.NewInt32(nil)
+	tests.AssertEqual(t, value.String(), "-42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `-42|"-42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "-42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewInt32Value(-42)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewInt32(nil)")
+}
+
+func TestLogValueInt32(t *testing.T) {
+	value := nullable.NewInt32Value(-42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindInt64)
+	tests.AssertEqual(t, value.Int64(), int64(-42))
+
+	null := nullable.NewInt32(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestInt32(t *testing.T) {
 	type TestNullableInt32 struct {
 		ID    uint
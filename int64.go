@@ -1,8 +1,15 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +35,14 @@ func NewInt64(value *int64) Int64 {
 	}
 }
 
+// NewInt64Value creates a new valid nullable 64-bit integer from plain value
+func NewInt64Value(value int64) Int64 {
+	return Int64{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 64-bit integer
 func (n Int64) Get() *int64 {
 	if !n.isValid {
@@ -46,22 +61,322 @@ func (n *Int64) Set(value *int64) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Int64) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Int64) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Int64) ValueOr(fallback int64) int64 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Int64) ValueOrZero() int64 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Int64) MustGet() int64 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Int64")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Int64) Clone() Int64 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Int64) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatInt(n.realValue, 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Int64) GoString() string {
+	if !n.isValid {
+		return "nullable.NewInt64(nil)"
+	}
+	return fmt.Sprintf("nullable.NewInt64Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Int64) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Int64) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Int64Value(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Int64) Map(fn func(int64) int64) Int64 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt64Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Int64) FlatMap(fn func(int64) Int64) Int64 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Int64) Filter(fn func(int64) bool) Int64 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewInt64(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Int64) Compare(other Int64, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Int64) Equal(other Int64) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Int64) Add(other Int64) Int64 {
+	if !n.isValid || !other.isValid {
+		return NewInt64(nil)
+	}
+	return NewInt64Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Int64) Sub(other Int64) Int64 {
+	if !n.isValid || !other.isValid {
+		return NewInt64(nil)
+	}
+	return NewInt64Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Int64) Mul(other Int64) Int64 {
+	if !n.isValid || !other.isValid {
+		return NewInt64(nil)
+	}
+	return NewInt64Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Int64) Div(other Int64) (Int64, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt64(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewInt64(nil), divisionByZero()
+	}
+	return NewInt64Value(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL, the minimum wraps around like Go, see NegChecked
+func (n Int64) Neg() Int64 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt64Value(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL, the minimum wraps around like Go, see AbsChecked
+func (n Int64) Abs() Int64 {
+	if !n.isValid || n.realValue >= 0 {
+		return n
+	}
+	return NewInt64Value(-n.realValue)
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Int64) AddChecked(other Int64) (Int64, error) {
+	return n.checked("+", other, addSigned[int64])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Int64) SubChecked(other Int64) (Int64, error) {
+	return n.checked("-", other, subSigned[int64])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Int64) MulChecked(other Int64) (Int64, error) {
+	return n.checked("*", other, mulSigned[int64])
+}
+
+// DivChecked is Div, but fails with *OverflowError when the minimum is divided by -1
+func (n Int64) DivChecked(other Int64) (Int64, error) {
+	if n.isValid && other.isValid && other.realValue == 0 {
+		return NewInt64(nil), divisionByZero()
+	}
+	return n.checked("/", other, divSigned[int64])
+}
+
+// NegChecked is Neg, but fails with *OverflowError for the minimum
+func (n Int64) NegChecked() (Int64, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt64(nil), overflowOf(operation{operator: "-", left: n.realValue}, new(Int64))
+	}
+	return n.Neg(), nil
+}
+
+// AbsChecked is Abs, but fails with *OverflowError for the minimum
+func (n Int64) AbsChecked() (Int64, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt64(nil), overflowOf(operation{operator: "abs", left: n.realValue}, new(Int64))
+	}
+	return n.Abs(), nil
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Int64) checked(operator string, other Int64, fn func(a, b int64) (int64, bool)) (Int64, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt64(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewInt64(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Int64))
+	}
+	return NewInt64Value(result), nil
+}
+
+// MarshalJSON converts current value to JSON, see SetLargeIntJSON
 func (n Int64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Int64) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeInt(dst, n.realValue, isLargeIntAsString())
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, both number and quoted number are accepted, failures are *DecodeError
 func (n *Int64) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Int64) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
+	digits, isQuoted := unquoteLargeInt(data)
 	var parsed int64
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if fast, isFast := parseJSONInt(digits, 64); isFast {
+		parsed = fast
+	} else if isQuoted {
+		quoted, err := parseQuotedInt(digits, 64)
+		if err != nil {
+			return err
+		}
+		parsed = quoted
+	} else {
+		var slow int64
+		if err := json.Unmarshal(digits, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Int64) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, n.realValue, 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Int64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Int64) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Int64) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Int64) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendVarint(buffer, n.realValue)
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Int64) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Int64")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := readVarint(payload, 64, "Int64")
+	if err != nil {
 		return err
 	}
 
@@ -70,14 +385,31 @@ func (n *Int64) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Int64) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Int64) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Int64) Scan(value interface{}) error {
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
+
+	parsed, err := scanInt(value, 64, n)
+	if err != nil {
+		return err
+	}
+	n.realValue = parsed
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -88,6 +420,27 @@ func (n Int64) Value() (driver.Value, error) {
 	return n.realValue, nil
 }
 
+// FromSQLNullInt64 converts sql.NullInt64 to nullable 64-bit integer
+func FromSQLNullInt64(value sql.NullInt64) Int64 {
+	if !value.Valid {
+		return NewInt64(nil)
+	}
+	return NewInt64Value(value.Int64)
+}
+
+// SQLNull converts to sql.NullInt64
+func (n Int64) SQLNull() sql.NullInt64 {
+	return sql.NullInt64{Int64: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Int64) JSONSchema() map[string]interface{} {
+	return largeIntSchema(map[string]interface{}{
+		"type":   []string{"integer", "null"},
+		"format": "int64",
+	}, isLargeIntAsString())
+}
+
 // GormDataType gorm common data type
 func (Int64) GormDataType() string {
 	return "int64_null"
@@ -103,3 +456,51 @@ func (Int64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// Int64String is Int64 which is always written to JSON as string, like "9007199254740993"
+type Int64String struct {
+	Int64
+}
+
+// NewInt64String creates a new nullable 64-bit integer which is written to JSON as string
+func NewInt64String(value *int64) Int64String {
+	return Int64String{NewInt64(value)}
+}
+
+// Clone returns a copy
+func (n Int64String) Clone() Int64String {
+	return Int64String{n.Int64.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Int64String) GoString() string {
+	return "nullable.Int64String{Int64: " + n.Int64.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Int64.Format
+func (n Int64String) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string
+func (n Int64String) MarshalJSON() ([]byte, error) {
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON string of current value to dst without reflection
+func (n Int64String) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeInt(dst, n.realValue, true)
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Int64String) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (n Int64String) JSONSchema() map[string]interface{} {
+	return largeIntSchema(n.Int64.JSONSchema(), true)
+}
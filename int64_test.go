@@ -1,6 +1,12 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -164,6 +170,249 @@ func TestJSONInt64(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt64(nil))
 }
 
+func TestGQLInt64(t *testing.T) {
+	var basicInt1 int64 = 37
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt1))
+
+	var basicInt2 int64 = -37
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt2))
+
+	var basicInt3 int64 = 1234
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt3))
+
+	var basicInt4 int64 = -1234
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt4))
+
+	var basicInt5 int64 = 654321
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt5))
+
+	var basicInt6 int64 = -654321
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt6))
+
+	var basicInt7 int64 = 50000000000
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt7))
+
+	var basicInt8 int64 = -50000000000
+	marshalUnmarshalGQL(t, nullable.NewInt64(&basicInt8))
+
+	marshalUnmarshalGQL(t, nullable.NewInt64(nil))
+}
+
+func TestJSONStringInt64(t *testing.T) {
+	var basicInt int64 = -9007199254740993
+	nullableInt := nullable.NewInt64(&basicInt)
+
+	serialized, _ := json.Marshal(nullableInt)
+	tests.AssertEqual(t, string(serialized), "-9007199254740993")
+
+	nullable.SetLargeIntJSON(nullable.LargeIntAsString)
+	serialized, _ = json.Marshal(nullableInt)
+	nullable.SetLargeIntJSON(nullable.LargeIntAsNumber)
+	tests.AssertEqual(t, string(serialized), `"-9007199254740993"`)
+
+	serialized, _ = json.Marshal(nullable.NewInt64String(&basicInt))
+	tests.AssertEqual(t, string(serialized), `"-9007199254740993"`)
+
+	serialized, _ = json.Marshal(nullable.NewInt64String(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	for _, input := range []string{"-9007199254740993", `"-9007199254740993"`} {
+		var unserialized nullable.Int64
+		if err := json.Unmarshal([]byte(input), &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserialized.Get(), basicInt)
+
+		var unserializedString nullable.Int64String
+		if err := json.Unmarshal([]byte(input), &unserializedString); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserializedString.Get(), basicInt)
+	}
+
+	for _, input := range []string{`"12a"`, `"null"`, `" 1"`, `"1 "`, `""`, `"1.5"`, `"99999999999999999999999"`} {
+		var invalid nullable.Int64
+		if err := json.Unmarshal([]byte(input), &invalid); err == nil {
+			t.Errorf("Expected error while unmarshalling malformed quoted number %s", input)
+		}
+		tests.AssertEqual(t, invalid.Valid(), false)
+	}
+}
+
+func TestTextInt64(t *testing.T) {
+	var basicInt1 int64 = 37
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt1))
+
+	var basicInt2 int64 = -37
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt2))
+
+	var basicInt3 int64 = 1234
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt3))
+
+	var basicInt4 int64 = -1234
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt4))
+
+	var basicInt5 int64 = 654321
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt5))
+
+	var basicInt6 int64 = -654321
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt6))
+
+	var basicInt7 int64 = 50000000000
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt7))
+
+	var basicInt8 int64 = -50000000000
+	marshalUnmarshalText(t, nullable.NewInt64(&basicInt8))
+
+	marshalUnmarshalText(t, nullable.NewInt64(nil))
+}
+
+func TestBinaryInt64(t *testing.T) {
+	var basicInt1 int64 = 37
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt1))
+
+	var basicInt2 int64 = -37
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt2))
+
+	var basicInt3 int64 = 1234
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt3))
+
+	var basicInt4 int64 = -1234
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt4))
+
+	var basicInt5 int64 = 654321
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt5))
+
+	var basicInt6 int64 = -654321
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt6))
+
+	var basicInt7 int64 = 50000000000
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt7))
+
+	var basicInt8 int64 = -50000000000
+	marshalUnmarshalBinary(t, nullable.NewInt64(&basicInt8))
+
+	marshalUnmarshalBinary(t, nullable.NewInt64(nil))
+}
+
+func TestAccessorInt64(t *testing.T) {
+	nullableValue := nullable.NewInt64Value(int64(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(int64(1)), int64(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), int64(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), int64(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v int64) int64 { return v + 1 }).MustGet(), int64(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(int64) nullable.Int64 { return nullable.NewInt64(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(int64) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(int64) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Int64](int64(37)), nullableValue)
+
+	nullValue := nullable.NewInt64(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(int64(1)), int64(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), int64(0))
+	tests.AssertEqual(t, nullValue.Map(func(v int64) int64 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(int64) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullInt64(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullInt64(sql.NullInt64{Int64: -37, Valid: true}).Get(), -37)
+	tests.AssertEqual(t, nullable.FromSQLNullInt64(sql.NullInt64{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewInt64Value(-37).SQLNull(), sql.NullInt64{Int64: -37, Valid: true})
+	tests.AssertEqual(t, nullable.NewInt64(nil).SQLNull(), sql.NullInt64{})
+}
+
+func TestArithmeticInt64(t *testing.T) {
+	a, b := nullable.NewInt64Value(7), nullable.NewInt64Value(-2)
+	null := nullable.NewInt64(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), int64(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), int64(9))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), int64(-14))
+	tests.AssertEqual(t, b.Neg().MustGet(), int64(2))
+	tests.AssertEqual(t, b.Abs().MustGet(), int64(2))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Abs().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), int64(-3))
+
+	max, min := nullable.NewInt64Value(math.MaxInt64), nullable.NewInt64Value(math.MinInt64)
+	one, minusOne := nullable.NewInt64Value(1), nullable.NewInt64Value(-1)
+	tests.AssertEqual(t, max.Add(one), min)
+	overflows := []func() (nullable.Int64, error){
+		func() (nullable.Int64, error) { return max.AddChecked(one) },
+		func() (nullable.Int64, error) { return min.SubChecked(one) },
+		func() (nullable.Int64, error) { return max.MulChecked(b) },
+		func() (nullable.Int64, error) { return min.MulChecked(minusOne) },
+		func() (nullable.Int64, error) { return min.DivChecked(minusOne) },
+		func() (nullable.Int64, error) { return min.NegChecked() },
+		func() (nullable.Int64, error) { return min.AbsChecked() },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	sum, err := a.AddChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum.MustGet(), int64(5))
+
+	quotient, err = a.Div(nullable.NewInt64Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewInt64Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareInt64(t *testing.T) {
+	low, high := nullable.NewInt64Value(int64(-37)), nullable.NewInt64Value(int64(37))
+	null := nullable.NewInt64(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewInt64Value(int64(-37)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewInt64(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewInt64Value(int64(-37))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewInt64(nil)), true)
+}
+
+func TestFormatInt64(t *testing.T) {
+	value := nullable.NewInt64Value(-42)
+	null := nullable.NewInt64(nil)
+	tests.AssertEqual(t, value.String(), "-42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `-42|"-42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "-42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewInt64Value(-42)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewInt64(nil)")
+}
+
+func TestLogValueInt64(t *testing.T) {
+	value := nullable.NewInt64Value(-42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindInt64)
+	tests.AssertEqual(t, value.Int64(), int64(-42))
+
+	null := nullable.NewInt64(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestInt64(t *testing.T) {
 	type TestNullableInt64 struct {
 		ID    uint
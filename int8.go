@@ -1,8 +1,15 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +35,14 @@ func NewInt8(value *int8) Int8 {
 	}
 }
 
+// NewInt8Value creates a new valid nullable 8-bit integer from plain value
+func NewInt8Value(value int8) Int8 {
+	return Int8{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 8-bit integer
 func (n Int8) Get() *int8 {
 	if !n.isValid {
@@ -46,30 +61,333 @@ func (n *Int8) Set(value *int8) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Int8) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Int8) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Int8) ValueOr(fallback int8) int8 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Int8) ValueOrZero() int8 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Int8) MustGet() int8 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Int8")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Int8) Clone() Int8 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Int8) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatInt(int64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Int8) GoString() string {
+	if !n.isValid {
+		return "nullable.NewInt8(nil)"
+	}
+	return fmt.Sprintf("nullable.NewInt8Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Int8) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Int8) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Int64Value(int64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Int8) Map(fn func(int8) int8) Int8 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt8Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Int8) FlatMap(fn func(int8) Int8) Int8 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Int8) Filter(fn func(int8) bool) Int8 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewInt8(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Int8) Compare(other Int8, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Int8) Equal(other Int8) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Int8) Add(other Int8) Int8 {
+	if !n.isValid || !other.isValid {
+		return NewInt8(nil)
+	}
+	return NewInt8Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Int8) Sub(other Int8) Int8 {
+	if !n.isValid || !other.isValid {
+		return NewInt8(nil)
+	}
+	return NewInt8Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Int8) Mul(other Int8) Int8 {
+	if !n.isValid || !other.isValid {
+		return NewInt8(nil)
+	}
+	return NewInt8Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Int8) Div(other Int8) (Int8, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt8(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewInt8(nil), divisionByZero()
+	}
+	return NewInt8Value(n.realValue / other.realValue), nil
+}
+
+// Neg returns -n, NULL stays NULL, the minimum wraps around like Go, see NegChecked
+func (n Int8) Neg() Int8 {
+	if !n.isValid {
+		return n
+	}
+	return NewInt8Value(-n.realValue)
+}
+
+// Abs returns absolute value, NULL stays NULL, the minimum wraps around like Go, see AbsChecked
+func (n Int8) Abs() Int8 {
+	if !n.isValid || n.realValue >= 0 {
+		return n
+	}
+	return NewInt8Value(-n.realValue)
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Int8) AddChecked(other Int8) (Int8, error) {
+	return n.checked("+", other, addSigned[int8])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Int8) SubChecked(other Int8) (Int8, error) {
+	return n.checked("-", other, subSigned[int8])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Int8) MulChecked(other Int8) (Int8, error) {
+	return n.checked("*", other, mulSigned[int8])
+}
+
+// DivChecked is Div, but fails with *OverflowError when the minimum is divided by -1
+func (n Int8) DivChecked(other Int8) (Int8, error) {
+	if n.isValid && other.isValid && other.realValue == 0 {
+		return NewInt8(nil), divisionByZero()
+	}
+	return n.checked("/", other, divSigned[int8])
+}
+
+// NegChecked is Neg, but fails with *OverflowError for the minimum
+func (n Int8) NegChecked() (Int8, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt8(nil), overflowOf(operation{operator: "-", left: n.realValue}, new(Int8))
+	}
+	return n.Neg(), nil
+}
+
+// AbsChecked is Abs, but fails with *OverflowError for the minimum
+func (n Int8) AbsChecked() (Int8, error) {
+	if n.isValid && isMinSigned(n.realValue) {
+		return NewInt8(nil), overflowOf(operation{operator: "abs", left: n.realValue}, new(Int8))
+	}
+	return n.Abs(), nil
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Int8) checked(operator string, other Int8, fn func(a, b int8) (int8, bool)) (Int8, error) {
+	if !n.isValid || !other.isValid {
+		return NewInt8(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewInt8(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Int8))
+	}
+	return NewInt8Value(result), nil
+}
+
 // MarshalJSON converts current value to JSON
 func (n Int8) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Int8) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendInt(dst, int64(n.realValue), 10)
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Int8) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Int8) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed int8
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if fast, isFast := parseJSONInt(data, 8); isFast {
+		parsed = int8(fast)
+	} else {
+		var slow int8
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Int8) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Int8) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 8)
+	if err != nil {
 		return err
 	}
 
+	n.isValid = true
+	n.realValue = int8(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Int8) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Int8) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Int8) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, 1)
+	if n.isValid {
+		buffer = append(buffer, byte(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Int8) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Int8")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	if err := readFixed(payload, 1, "Int8"); err != nil {
+		return err
+	}
+	parsed := int8(payload[0])
+
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Int8) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Int8) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Int8) Scan(value interface{}) error {
 	if value == nil {
@@ -77,11 +395,11 @@ func (n *Int8) Scan(value interface{}) error {
 		return nil
 	}
 
-	var i64 int64
-	if err := convertAssign(&i64, value); err != nil {
+	parsed, err := scanInt(value, 8, n)
+	if err != nil {
 		return err
 	}
-	n.realValue = int8(i64)
+	n.realValue = int8(parsed)
 
 	n.isValid = true
 	return nil
@@ -95,6 +413,45 @@ func (n Int8) Value() (driver.Value, error) {
 	return int64(n.realValue), nil
 }
 
+// FromSQLNullInt8 converts sql.NullInt16 to nullable 8-bit integer, fails with *OverflowError when out of range
+func FromSQLNullInt8(value sql.NullInt16) (Int8, error) {
+	if !value.Valid {
+		return NewInt8(nil), nil
+	}
+	v := value.Int16
+	if int16(int8(v)) != v {
+		return NewInt8(nil), overflowOf(v, new(Int8))
+	}
+	return NewInt8Value(int8(v)), nil
+}
+
+// FromSQLNullInt64ToInt8 converts sql.NullInt64 to nullable 8-bit integer, fails with *OverflowError when out of range
+func FromSQLNullInt64ToInt8(value sql.NullInt64) (Int8, error) {
+	if !value.Valid {
+		return NewInt8(nil), nil
+	}
+	v := value.Int64
+	if int64(int8(v)) != v {
+		return NewInt8(nil), overflowOf(v, new(Int8))
+	}
+	return NewInt8Value(int8(v)), nil
+}
+
+// SQLNull converts to sql.NullInt16
+func (n Int8) SQLNull() sql.NullInt16 {
+	return sql.NullInt16{Int16: int16(n.realValue), Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Int8) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int32",
+		"minimum": math.MinInt8,
+		"maximum": math.MaxInt8,
+	}
+}
+
 // GormDataType gorm common data type
 func (Int8) GormDataType() string {
 	return "int8_null"
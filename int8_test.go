@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -62,6 +67,183 @@ func TestJSONInt8(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt8(nil))
 }
 
+func TestGQLInt8(t *testing.T) {
+	var basicInt1 int8 = 37
+	marshalUnmarshalGQL(t, nullable.NewInt8(&basicInt1))
+
+	var basicInt2 int8 = -37
+	marshalUnmarshalGQL(t, nullable.NewInt8(&basicInt2))
+
+	marshalUnmarshalGQL(t, nullable.NewInt8(nil))
+}
+
+func TestTextInt8(t *testing.T) {
+	var basicInt1 int8 = 37
+	marshalUnmarshalText(t, nullable.NewInt8(&basicInt1))
+
+	var basicInt2 int8 = -37
+	marshalUnmarshalText(t, nullable.NewInt8(&basicInt2))
+
+	marshalUnmarshalText(t, nullable.NewInt8(nil))
+}
+
+func TestBinaryInt8(t *testing.T) {
+	var basicInt1 int8 = 37
+	marshalUnmarshalBinary(t, nullable.NewInt8(&basicInt1))
+
+	var basicInt2 int8 = -37
+	marshalUnmarshalBinary(t, nullable.NewInt8(&basicInt2))
+
+	marshalUnmarshalBinary(t, nullable.NewInt8(nil))
+}
+
+func TestAccessorInt8(t *testing.T) {
+	nullableValue := nullable.NewInt8Value(int8(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(int8(1)), int8(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), int8(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), int8(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v int8) int8 { return v + 1 }).MustGet(), int8(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(int8) nullable.Int8 { return nullable.NewInt8(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(int8) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(int8) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Int8](int8(37)), nullableValue)
+
+	nullValue := nullable.NewInt8(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(int8(1)), int8(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), int8(0))
+	tests.AssertEqual(t, nullValue.Map(func(v int8) int8 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(int8) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullInt8(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt8(sql.NullInt16{Int16: -37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt16 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), -37)
+
+	converted, err = nullable.FromSQLNullInt8(sql.NullInt16{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewInt8Value(-37).SQLNull(), sql.NullInt16{Int16: -37, Valid: true})
+	tests.AssertEqual(t, nullable.NewInt8(nil).SQLNull(), sql.NullInt16{})
+
+	if _, err := nullable.FromSQLNullInt8(sql.NullInt16{Int16: 128, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting 128 to Int8, got %v", err)
+	}
+}
+
+func TestSQLNullInt64ToInt8(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt64ToInt8(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullInt64ToInt8(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	for _, outOfRange := range []int64{128, -129} {
+		if _, err := nullable.FromSQLNullInt64ToInt8(sql.NullInt64{Int64: outOfRange, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while converting %d to Int8, got %v", outOfRange, err)
+		}
+	}
+}
+
+func TestArithmeticInt8(t *testing.T) {
+	a, b := nullable.NewInt8Value(7), nullable.NewInt8Value(-2)
+	null := nullable.NewInt8(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), int8(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), int8(9))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), int8(-14))
+	tests.AssertEqual(t, b.Neg().MustGet(), int8(2))
+	tests.AssertEqual(t, b.Abs().MustGet(), int8(2))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Abs().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), int8(-3))
+
+	max, min := nullable.NewInt8Value(math.MaxInt8), nullable.NewInt8Value(math.MinInt8)
+	one, minusOne := nullable.NewInt8Value(1), nullable.NewInt8Value(-1)
+	tests.AssertEqual(t, max.Add(one), min)
+	overflows := []func() (nullable.Int8, error){
+		func() (nullable.Int8, error) { return max.AddChecked(one) },
+		func() (nullable.Int8, error) { return min.SubChecked(one) },
+		func() (nullable.Int8, error) { return max.MulChecked(b) },
+		func() (nullable.Int8, error) { return min.MulChecked(minusOne) },
+		func() (nullable.Int8, error) { return min.DivChecked(minusOne) },
+		func() (nullable.Int8, error) { return min.NegChecked() },
+		func() (nullable.Int8, error) { return min.AbsChecked() },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	sum, err := a.AddChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum.MustGet(), int8(5))
+
+	quotient, err = a.Div(nullable.NewInt8Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewInt8Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareInt8(t *testing.T) {
+	low, high := nullable.NewInt8Value(int8(-37)), nullable.NewInt8Value(int8(37))
+	null := nullable.NewInt8(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewInt8Value(int8(-37)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewInt8(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewInt8Value(int8(-37))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewInt8(nil)), true)
+}
+
+func TestFormatInt8(t *testing.T) {
+	value := nullable.NewInt8Value(-42)
+	null := nullable.NewInt8(nil)
+	tests.AssertEqual(t, value.String(), "-42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `-42|"-42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "-42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewInt8Value(-42)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewInt8(nil)")
+}
+
+func TestLogValueInt8(t *testing.T) {
+	value := nullable.NewInt8Value(-42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindInt64)
+	tests.AssertEqual(t, value.Int64(), int64(-42))
+
+	null := nullable.NewInt8(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestInt8(t *testing.T) {
 	type TestNullableInt8 struct {
 		ID    uint
@@ -1,6 +1,12 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -164,6 +170,256 @@ func TestJSONInt(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt(nil))
 }
 
+func TestGQLInt(t *testing.T) {
+	var basicInt1 int = 37
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt1))
+
+	var basicInt2 int = -37
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt2))
+
+	var basicInt3 int = 1234
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt3))
+
+	var basicInt4 int = -1234
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt4))
+
+	var basicInt5 int = 654321
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt5))
+
+	var basicInt6 int = -654321
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt6))
+
+	var basicInt7 int = 50000000000
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt7))
+
+	var basicInt8 int = -50000000000
+	marshalUnmarshalGQL(t, nullable.NewInt(&basicInt8))
+
+	marshalUnmarshalGQL(t, nullable.NewInt(nil))
+}
+
+func TestJSONStringInt(t *testing.T) {
+	var basicInt int = 9007199254740993
+	nullableInt := nullable.NewInt(&basicInt)
+
+	serialized, _ := json.Marshal(nullableInt)
+	tests.AssertEqual(t, string(serialized), "9007199254740993")
+
+	nullable.SetLargeIntJSON(nullable.LargeIntAsString)
+	serialized, _ = json.Marshal(nullableInt)
+	nullable.SetLargeIntJSON(nullable.LargeIntAsNumber)
+	tests.AssertEqual(t, string(serialized), `"9007199254740993"`)
+
+	serialized, _ = json.Marshal(nullable.NewIntString(&basicInt))
+	tests.AssertEqual(t, string(serialized), `"9007199254740993"`)
+
+	serialized, _ = json.Marshal(nullable.NewIntString(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	for _, input := range []string{"9007199254740993", `"9007199254740993"`} {
+		var unserialized nullable.Int
+		if err := json.Unmarshal([]byte(input), &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserialized.Get(), basicInt)
+
+		var unserializedString nullable.IntString
+		if err := json.Unmarshal([]byte(input), &unserializedString); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserializedString.Get(), basicInt)
+	}
+
+	for _, input := range []string{`"12a"`, `"null"`, `" 1"`, `"1 "`, `""`, `"1.5"`, `"99999999999999999999999"`} {
+		var invalid nullable.Int
+		if err := json.Unmarshal([]byte(input), &invalid); err == nil {
+			t.Errorf("Expected error while unmarshalling malformed quoted number %s", input)
+		}
+		tests.AssertEqual(t, invalid.Valid(), false)
+	}
+}
+
+func TestTextInt(t *testing.T) {
+	var basicInt1 int = 37
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt1))
+
+	var basicInt2 int = -37
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt2))
+
+	var basicInt3 int = 1234
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt3))
+
+	var basicInt4 int = -1234
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt4))
+
+	var basicInt5 int = 654321
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt5))
+
+	var basicInt6 int = -654321
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt6))
+
+	var basicInt7 int = 50000000000
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt7))
+
+	var basicInt8 int = -50000000000
+	marshalUnmarshalText(t, nullable.NewInt(&basicInt8))
+
+	marshalUnmarshalText(t, nullable.NewInt(nil))
+}
+
+func TestBinaryInt(t *testing.T) {
+	var basicInt1 int = 37
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt1))
+
+	var basicInt2 int = -37
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt2))
+
+	var basicInt3 int = 1234
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt3))
+
+	var basicInt4 int = -1234
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt4))
+
+	var basicInt5 int = 654321
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt5))
+
+	var basicInt6 int = -654321
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt6))
+
+	var basicInt7 int = 50000000000
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt7))
+
+	var basicInt8 int = -50000000000
+	marshalUnmarshalBinary(t, nullable.NewInt(&basicInt8))
+
+	marshalUnmarshalBinary(t, nullable.NewInt(nil))
+}
+
+func TestAccessorInt(t *testing.T) {
+	nullableValue := nullable.NewIntValue(int(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(int(1)), int(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), int(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), int(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v int) int { return v + 1 }).MustGet(), int(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(int) nullable.Int { return nullable.NewInt(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(int) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(int) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Int](int(37)), nullableValue)
+
+	nullValue := nullable.NewInt(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(int(1)), int(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), int(0))
+	tests.AssertEqual(t, nullValue.Map(func(v int) int { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(int) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullInt(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt(sql.NullInt64{Int64: -37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), -37)
+
+	converted, err = nullable.FromSQLNullInt(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewIntValue(-37).SQLNull(), sql.NullInt64{Int64: -37, Valid: true})
+	tests.AssertEqual(t, nullable.NewInt(nil).SQLNull(), sql.NullInt64{})
+}
+
+func TestArithmeticInt(t *testing.T) {
+	a, b := nullable.NewIntValue(7), nullable.NewIntValue(-2)
+	null := nullable.NewInt(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), int(5))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), int(9))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), int(-14))
+	tests.AssertEqual(t, b.Neg().MustGet(), int(2))
+	tests.AssertEqual(t, b.Abs().MustGet(), int(2))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+	tests.AssertEqual(t, null.Abs().IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), int(-3))
+
+	max, min := nullable.NewIntValue(math.MaxInt), nullable.NewIntValue(math.MinInt)
+	one, minusOne := nullable.NewIntValue(1), nullable.NewIntValue(-1)
+	tests.AssertEqual(t, max.Add(one), min)
+	overflows := []func() (nullable.Int, error){
+		func() (nullable.Int, error) { return max.AddChecked(one) },
+		func() (nullable.Int, error) { return min.SubChecked(one) },
+		func() (nullable.Int, error) { return max.MulChecked(b) },
+		func() (nullable.Int, error) { return min.MulChecked(minusOne) },
+		func() (nullable.Int, error) { return min.DivChecked(minusOne) },
+		func() (nullable.Int, error) { return min.NegChecked() },
+		func() (nullable.Int, error) { return min.AbsChecked() },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	sum, err := a.AddChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum.MustGet(), int(5))
+
+	quotient, err = a.Div(nullable.NewIntValue(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewIntValue(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareInt(t *testing.T) {
+	low, high := nullable.NewIntValue(int(-37)), nullable.NewIntValue(int(37))
+	null := nullable.NewInt(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewIntValue(int(-37)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewInt(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewIntValue(int(-37))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewInt(nil)), true)
+}
+
+func TestFormatInt(t *testing.T) {
+	value := nullable.NewIntValue(-42)
+	null := nullable.NewInt(nil)
+	tests.AssertEqual(t, value.String(), "-42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `-42|"-42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "-42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewIntValue(-42)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewInt(nil)")
+}
+
+func TestLogValueInt(t *testing.T) {
+	value := nullable.NewIntValue(-42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindInt64)
+	tests.AssertEqual(t, value.Int64(), int64(-42))
+
+	null := nullable.NewInt(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestInt(t *testing.T) {
 	type TestNullableInt struct {
 		ID    uint
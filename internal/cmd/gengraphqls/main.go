@@ -0,0 +1,80 @@
+// Command gengraphqls generates GraphQL scalar definitions for the nullable
+// types which have no standard GraphQL counterpart. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+)
+
+const modulePath = "github.com/Thor-x86/nullable"
+
+// scalar is a GraphQL scalar and the nullable types mapped into it
+type scalar struct {
+	name        string
+	description string
+	types       []string
+	isStandard  bool
+}
+
+var scalars = []scalar{
+	{"Boolean", "", []string{"Bool"}, true},
+	{"String", "", []string{"String"}, true},
+	{"Float", "", []string{"Float32", "Float64"}, true},
+	{"Int", "", []string{"Byte", "Int8", "Int16", "Int32", "Uint8", "Uint16"}, true},
+	{"Int64", "64-bit signed integer, serialized as JSON number, or as JSON string after SetLargeIntJSON(LargeIntAsString)", []string{"Int", "Int64"}, false},
+	{"Int64String", "64-bit signed integer, serialized as JSON string like \"-9007199254740993\"", []string{"IntString", "Int64String"}, false},
+	{"Uint32", "32-bit unsigned integer, serialized as JSON number", []string{"Uint32"}, false},
+	{"Uint64", "64-bit unsigned integer, serialized as JSON number, or as JSON string after SetLargeIntJSON(LargeIntAsString)", []string{"Uint", "Uint64"}, false},
+	{"Uint64String", "64-bit unsigned integer, serialized as JSON string like \"18446744073709551615\"", []string{"UintString", "Uint64String"}, false},
+	{"Time", "Date and time, RFC 3339 like \"2006-01-02T15:04:05Z\" by default, SetTimeLayouts changes the layout and SetTimeJSON changes it into Unix epoch number", []string{"Time"}, false},
+	{"TimeUnix", "Date and time as Unix epoch seconds", []string{"TimeUnix"}, false},
+	{"TimeUnixMilli", "Date and time as Unix epoch milliseconds", []string{"TimeUnixMilli"}, false},
+	{"TimeUnixNano", "Date and time as Unix epoch nanoseconds", []string{"TimeUnixNano"}, false},
+	{"Bytes", "Array of bytes, standard base64 encoded by default, SetBytesJSON changes it into URL-safe base64 or hex", []string{"Bytes"}, false},
+	{"BytesBase64URL", "Array of bytes, URL-safe base64 encoded without padding", []string{"BytesBase64URL"}, false},
+	{"BytesHex", "Array of bytes, encoded as lowercase hex digits", []string{"BytesHex"}, false},
+	{"ByteHex", "Single byte, encoded as two lowercase hex digits like \"ff\"", []string{"ByteHex"}, false},
+}
+
+func render() []byte {
+	var output bytes.Buffer
+	fmt.Fprintln(&output, "# Code generated by internal/cmd/gengraphqls. DO NOT EDIT.")
+	fmt.Fprintln(&output, "#")
+	fmt.Fprintln(&output, "# Scalars for nullable types without a standard GraphQL counterpart.")
+	fmt.Fprintln(&output, "# Map every nullable type in gqlgen.yml:")
+	fmt.Fprintln(&output, "#")
+	fmt.Fprintln(&output, "#   models:")
+	for _, current := range scalars {
+		fmt.Fprintf(&output, "#     %s:\n", current.name)
+		fmt.Fprintln(&output, "#       model:")
+		if current.isStandard {
+			fmt.Fprintf(&output, "#         - github.com/99designs/gqlgen/graphql.%s\n", current.name)
+		}
+		for _, typeName := range current.types {
+			fmt.Fprintf(&output, "#         - %s.%s\n", modulePath, typeName)
+		}
+	}
+
+	for _, current := range scalars {
+		if current.isStandard {
+			continue
+		}
+		fmt.Fprintln(&output)
+		fmt.Fprintf(&output, "%q\n", current.description)
+		fmt.Fprintf(&output, "scalar %s\n", current.name)
+	}
+	return output.Bytes()
+}
+
+func main() {
+	outputPath := flag.String("o", "nullable.graphqls", "output file")
+	flag.Parse()
+
+	if err := ioutil.WriteFile(*outputPath, render(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
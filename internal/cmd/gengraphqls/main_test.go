@@ -0,0 +1,17 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	generated, err := ioutil.ReadFile("../../../nullable.graphqls")
+	if err != nil {
+		t.Fatalf("Failed to read nullable.graphqls because: %s", err)
+	}
+	if !bytes.Equal(generated, render()) {
+		t.Error("nullable.graphqls is outdated, run go generate")
+	}
+}
@@ -0,0 +1,191 @@
+// Package fields walks struct fields and converts them from and to text.
+// It is shared by the sub packages which bind nullable types to text sources.
+package fields
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Field is an exported struct field found by Walk
+type Field struct {
+	// Path is the Go path of the field, like "Database.Port"
+	Path string
+	// Name is the name part of the tag, empty if the field is not tagged
+	Name string
+	// Options are the comma separated parts after the name
+	Options []string
+	// Index is the index sequence for reflect.Value.FieldByIndex
+	Index []int
+	// Value is the addressable field itself
+	Value reflect.Value
+}
+
+// HasOption tells whether the tag contains given option
+func (f Field) HasOption(option string) bool {
+	for _, current := range f.Options {
+		if current == option {
+			return true
+		}
+	}
+	return false
+}
+
+// Struct returns the struct which target points to, or error if it isn't a non-nil pointer to struct
+func Struct(target interface{}) (reflect.Value, error) {
+	pointer := reflect.ValueOf(target)
+	if pointer.Kind() != reflect.Ptr || pointer.IsNil() || pointer.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("target must be a non-nil pointer to struct, got %T", target)
+	}
+	return pointer.Elem(), nil
+}
+
+// Walk calls fn for every exported field of given struct in declaration order.
+// Untagged nested structs are walked recursively unless they can be parsed from text,
+// like nullable.Time. Fields tagged with "-" are skipped. Untagged pointers to
+// such structs are not allocated, so they fail with an error instead.
+func Walk(value reflect.Value, key string, fn func(Field) error) error {
+	return walk(value, key, "", nil, fn)
+}
+
+func walk(value reflect.Value, key string, prefix string, parent []int, fn func(Field) error) error {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		structField := valueType.Field(i)
+		if structField.PkgPath != "" && !structField.Anonymous {
+			continue
+		}
+
+		tag, isTagged := structField.Tag.Lookup(key)
+		if tag == "-" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		path := prefix + structField.Name
+		index := append(append([]int{}, parent...), i)
+		if !isTagged && fieldValue.Kind() == reflect.Struct && !IsText(fieldValue.Type()) {
+			if err := walk(fieldValue, key, path+".", index, fn); err != nil {
+				return err
+			}
+			continue
+		}
+		if !isTagged && isStructPointer(fieldValue.Type()) {
+			return fmt.Errorf("unsupported type %s of field %s", fieldValue.Type(), path)
+		}
+		if structField.PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		if err := fn(Field{Path: path, Name: parts[0], Options: parts[1:], Index: index, Value: fieldValue}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isStructPointer tells whether valueType points to a struct which can't be parsed from text
+func isStructPointer(valueType reflect.Type) bool {
+	return valueType.Kind() == reflect.Ptr && valueType.Elem().Kind() == reflect.Struct && !IsText(valueType.Elem())
+}
+
+// IsText tells whether values of given type can be parsed from text by SetText
+func IsText(valueType reflect.Type) bool {
+	if reflect.PtrTo(valueType).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch valueType.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// SetText parses text into addressable value, through encoding.TextUnmarshaler
+// if implemented, otherwise through strconv for basic kinds.
+func SetText(value reflect.Value, text string) error {
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(text))
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(text)
+		return nil
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(parsed)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(text, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(parsed)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetFloat(parsed)
+		return nil
+	}
+	return fmt.Errorf("unsupported field type %s", value.Type())
+}
+
+// Text formats value as text, through encoding.TextMarshaler if implemented,
+// otherwise through strconv for basic kinds.
+func Text(value reflect.Value) (string, error) {
+	if value.Type().Implements(textMarshalerType) {
+		text, err := value.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, value.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("unsupported field type %s", value.Type())
+}
+
+// IsNull tells whether value is SQL NULL, which is when its driver.Valuer returns nil
+func IsNull(value reflect.Value) bool {
+	valuer, ok := value.Interface().(driver.Valuer)
+	if !ok {
+		return false
+	}
+	driverValue, err := valuer.Value()
+	return err == nil && driverValue == nil
+}
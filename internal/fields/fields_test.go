@@ -0,0 +1,137 @@
+package fields
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+)
+
+type testInner struct {
+	Port uint16 `test:"port,required"`
+}
+
+type testOuter struct {
+	Name    string `test:"name"`
+	Inner   testInner
+	Skipped int `test:"-"`
+	hidden  int
+	Ratio   float32
+}
+
+func TestWalk(t *testing.T) {
+	var target testOuter
+	value, err := Struct(&target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found []Field
+	Walk(value, "test", func(field Field) error {
+		found = append(found, field)
+		return nil
+	})
+
+	if len(found) != 3 {
+		t.Fatalf("Expected 3 fields, got %d", len(found))
+	}
+	if found[0].Path != "Name" || found[0].Name != "name" {
+		t.Errorf("Unexpected first field %+v", found[0])
+	}
+	if found[1].Path != "Inner.Port" || !found[1].HasOption("required") || !reflect.DeepEqual(found[1].Index, []int{1, 0}) {
+		t.Errorf("Unexpected second field %+v", found[1])
+	}
+	if found[2].Path != "Ratio" || found[2].Name != "" {
+		t.Errorf("Unexpected third field %+v", found[2])
+	}
+}
+
+func TestWalkStructPointer(t *testing.T) {
+	var target struct {
+		Inner *testInner
+	}
+	value, _ := Struct(&target)
+
+	err := Walk(value, "test", func(field Field) error {
+		return nil
+	})
+	if err == nil || target.Inner != nil {
+		t.Errorf("Expected error without allocating untagged pointer to struct, got %v", err)
+	}
+
+	var tagged struct {
+		Tagged *testInner `test:"tagged"`
+	}
+	value, _ = Struct(&tagged)
+	var found []Field
+	if err := Walk(value, "test", func(field Field) error {
+		found = append(found, field)
+		return nil
+	}); err != nil || len(found) != 1 {
+		t.Errorf("Expected tagged pointer to be passed to fn, got %d fields and error %v", len(found), err)
+	}
+}
+
+func TestStruct(t *testing.T) {
+	if _, err := Struct(testOuter{}); err == nil {
+		t.Error("Expected error for non-pointer")
+	}
+	if _, err := Struct((*testOuter)(nil)); err == nil {
+		t.Error("Expected error for nil pointer")
+	}
+}
+
+func TestSetText(t *testing.T) {
+	var target testOuter
+	value := reflect.ValueOf(&target).Elem()
+
+	if err := SetText(value.FieldByName("Name"), "nullable"); err != nil || target.Name != "nullable" {
+		t.Errorf("Failed to set string, got %q and error %v", target.Name, err)
+	}
+	if err := SetText(value.FieldByName("Ratio"), "0.5"); err != nil || target.Ratio != 0.5 {
+		t.Errorf("Failed to set float, got %v and error %v", target.Ratio, err)
+	}
+	if err := SetText(value.FieldByName("Inner").FieldByName("Port"), "65536"); err == nil {
+		t.Error("Expected error for out of range uint16")
+	}
+	if err := SetText(value.FieldByName("Inner"), "{}"); err == nil {
+		t.Error("Expected error for unsupported struct")
+	}
+}
+
+func TestText(t *testing.T) {
+	target := testOuter{Name: "nullable", Ratio: 0.25}
+	value := reflect.ValueOf(target)
+
+	if text, err := Text(value.FieldByName("Name")); err != nil || text != "nullable" {
+		t.Errorf("Failed to format string, got %q and error %v", text, err)
+	}
+	if text, err := Text(value.FieldByName("Ratio")); err != nil || text != "0.25" {
+		t.Errorf("Failed to format float, got %q and error %v", text, err)
+	}
+	if _, err := Text(value.FieldByName("Inner")); err == nil {
+		t.Error("Expected error for unsupported struct")
+	}
+}
+
+type testValuer struct {
+	isNull bool
+}
+
+func (v testValuer) Value() (driver.Value, error) {
+	if v.isNull {
+		return nil, nil
+	}
+	return int64(1), nil
+}
+
+func TestIsNull(t *testing.T) {
+	if !IsNull(reflect.ValueOf(testValuer{isNull: true})) {
+		t.Error("Expected NULL valuer to be NULL")
+	}
+	if IsNull(reflect.ValueOf(testValuer{})) {
+		t.Error("Expected valid valuer not to be NULL")
+	}
+	if IsNull(reflect.ValueOf("")) {
+		t.Error("Expected plain string not to be NULL")
+	}
+}
@@ -0,0 +1,436 @@
+package nullable
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// LargeIntJSON decides how Int, Int64, Uint, and Uint64 are written to JSON
+type LargeIntJSON int32
+
+const (
+	// LargeIntAsNumber writes bare JSON numbers, this is the default
+	LargeIntAsNumber LargeIntJSON = iota
+	// LargeIntAsString writes quoted numbers like "9007199254740993",
+	// because JavaScript loses precision above 2^53
+	LargeIntAsString
+)
+
+var largeIntJSON int32
+
+// SetLargeIntJSON changes how every Int, Int64, Uint, and Uint64 is written to JSON.
+// Use Int64String, Uint64String, IntString, or UintString instead to select
+// string form per field. Both forms are always accepted while unmarshalling.
+func SetLargeIntJSON(mode LargeIntJSON) {
+	atomic.StoreInt32(&largeIntJSON, int32(mode))
+}
+
+func isLargeIntAsString() bool {
+	return LargeIntJSON(atomic.LoadInt32(&largeIntJSON)) == LargeIntAsString
+}
+
+// unquoteLargeInt strips the quotes of quoted integer, other JSON is returned as is
+func unquoteLargeInt(data []byte) ([]byte, bool) {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		return data[1 : len(data)-1], true
+	}
+	return data, false
+}
+
+// parseQuotedInt parses the digits inside quoted integer, only strict JSON integer
+// grammar is accepted, so "null" or " 1" never reach encoding/json as bare JSON
+func parseQuotedInt(digits []byte, bits int) (int64, error) {
+	if !isJSONNumber(digits, true) {
+		return 0, fmt.Errorf("quoted integer: invalid syntax %q", digits)
+	}
+	return strconv.ParseInt(string(digits), 10, bits)
+}
+
+// parseQuotedUint is parseQuotedInt for unsigned integer
+func parseQuotedUint(digits []byte, bits int) (uint64, error) {
+	if !isJSONNumber(digits, true) {
+		return 0, fmt.Errorf("quoted integer: invalid syntax %q", digits)
+	}
+	return strconv.ParseUint(string(digits), 10, bits)
+}
+
+// largeIntSchema changes the schema of large integer into string form when needed
+func largeIntSchema(described map[string]interface{}, asString bool) map[string]interface{} {
+	if asString {
+		described["type"] = []string{"string", "null"}
+		described["pattern"] = "^-?[0-9]+$"
+		if _, isUnsigned := described["minimum"]; isUnsigned {
+			described["pattern"] = "^[0-9]+$"
+			delete(described, "minimum")
+		}
+	}
+	return described
+}
+
+// JSONDecoding decides how strictly UnmarshalJSON checks JSON types
+type JSONDecoding int32
+
+const (
+	// StrictJSON only accepts the JSON type written by MarshalJSON, this is the default
+	StrictJSON JSONDecoding = iota
+	// LenientJSON accepts any JSON scalar and converts it like SQL scanning,
+	// so "42" fits into Int and 1 fits into Bool. On top of that, "yes" and "no"
+	// fit into Bool, and both epoch seconds and "2006-01-02 15:04:05" fit into Time.
+	LenientJSON
+)
+
+var jsonDecoding int32
+
+// SetJSONDecoding changes how every nullable type is read from JSON.
+// Bytes doesn't follow this mode, because its text is always decoded as
+// binary, see SetBytesJSON.
+func SetJSONDecoding(mode JSONDecoding) {
+	atomic.StoreInt32(&jsonDecoding, int32(mode))
+}
+
+func isLenientJSON() bool {
+	return JSONDecoding(atomic.LoadInt32(&jsonDecoding)) == LenientJSON
+}
+
+// decodeLenientJSON decodes a JSON scalar, numbers are kept as json.Number to avoid precision loss
+func decodeLenientJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("lenient JSON: unexpected data after %s", data)
+	}
+
+	switch value.(type) {
+	case json.Number, string, bool:
+		return value, nil
+	}
+	return nil, fmt.Errorf("lenient JSON: unsupported value %s", data)
+}
+
+// readLenientJSON writes any JSON scalar to target with the same conversion as SQL scanning
+func readLenientJSON(target sql.Scanner, data []byte) error {
+	value, err := decodeLenientJSON(data)
+	if err != nil {
+		return err
+	}
+	return scanLenientJSON(target, value)
+}
+
+// scanLenientJSON writes decoded JSON scalar to target by SQL scanning, numbers are scanned as text
+func scanLenientJSON(target sql.Scanner, value interface{}) error {
+	if number, isNumber := value.(json.Number); isNumber {
+		value = string(number)
+	}
+	return scanReason(target.Scan(value))
+}
+
+// TimeJSON decides how Time is written to JSON
+type TimeJSON int32
+
+const (
+	// TimeAsLayout writes text with the first layout of SetTimeLayouts, this is the default
+	TimeAsLayout TimeJSON = iota
+	// TimeAsUnix writes Unix epoch seconds
+	TimeAsUnix
+	// TimeAsUnixMilli writes Unix epoch milliseconds
+	TimeAsUnixMilli
+	// TimeAsUnixNano writes Unix epoch nanoseconds
+	TimeAsUnixNano
+)
+
+var timeJSON int32
+
+// SetTimeJSON changes how every Time is written to JSON. Use TimeUnix,
+// TimeUnixMilli, or TimeUnixNano instead to select epoch form per field.
+// Epoch numbers of the selected unit and text of any layout are accepted
+// while unmarshalling.
+func SetTimeJSON(mode TimeJSON) {
+	atomic.StoreInt32(&timeJSON, int32(mode))
+}
+
+// epochUnit returns the unit of epoch mode, or zero for layout mode
+func (mode TimeJSON) epochUnit() time.Duration {
+	switch mode {
+	case TimeAsUnix:
+		return time.Second
+	case TimeAsUnixMilli:
+		return time.Millisecond
+	case TimeAsUnixNano:
+		return time.Nanosecond
+	}
+	return 0
+}
+
+func currentTimeJSON() TimeJSON {
+	return TimeJSON(atomic.LoadInt32(&timeJSON))
+}
+
+// defaultTimeLayouts are RFC 3339 like encoding/json
+var defaultTimeLayouts = []string{time.RFC3339Nano}
+
+var timeLayouts atomic.Value
+
+// SetTimeLayouts changes the text layouts of Time in JSON and plain text, like "2006-01-02 15:04:05".
+// The first layout is used for writing, then every layout is tried in order
+// while reading. Calling it without layout restores RFC 3339.
+func SetTimeLayouts(layouts ...string) {
+	if len(layouts) == 0 {
+		layouts = defaultTimeLayouts
+	}
+	timeLayouts.Store(append([]string(nil), layouts...))
+}
+
+func currentTimeLayouts() []string {
+	if layouts, isSet := timeLayouts.Load().([]string); isSet {
+		return layouts
+	}
+	return defaultTimeLayouts
+}
+
+// BytesJSON decides how Bytes is written to JSON
+type BytesJSON int32
+
+const (
+	// BytesAsBase64 writes standard base64 with padding like encoding/json, this is the default
+	BytesAsBase64 BytesJSON = iota
+	// BytesAsBase64URL writes URL-safe base64 without padding
+	BytesAsBase64URL
+	// BytesAsHex writes lowercase hex digits
+	BytesAsHex
+)
+
+var bytesJSON int32
+
+// SetBytesJSON changes how every Bytes is written to JSON. Use BytesBase64URL
+// or BytesHex instead to select encoding per field. While unmarshalling, the
+// selected encoding is tried first, then base64 with or without padding in
+// both alphabets. Base64 modes never read hex, because "deadbeef" is valid
+// base64 too, so read hex with BytesHex or BytesAsHex.
+func SetBytesJSON(mode BytesJSON) {
+	atomic.StoreInt32(&bytesJSON, int32(mode))
+}
+
+func currentBytesJSON() BytesJSON {
+	return BytesJSON(atomic.LoadInt32(&bytesJSON))
+}
+
+// isJSONNull reports whether data is empty or JSON null
+func isJSONNull(data []byte) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
+// appendLargeInt appends signed integer as JSON number, or as JSON string when asString is true
+func appendLargeInt(dst []byte, value int64, asString bool) []byte {
+	if !asString {
+		return strconv.AppendInt(dst, value, 10)
+	}
+	dst = append(dst, '"')
+	dst = strconv.AppendInt(dst, value, 10)
+	return append(dst, '"')
+}
+
+// appendLargeUint appends unsigned integer as JSON number, or as JSON string when asString is true
+func appendLargeUint(dst []byte, value uint64, asString bool) []byte {
+	if !asString {
+		return strconv.AppendUint(dst, value, 10)
+	}
+	dst = append(dst, '"')
+	dst = strconv.AppendUint(dst, value, 10)
+	return append(dst, '"')
+}
+
+// appendJSONFloat appends float the same way as encoding/json
+func appendJSONFloat(dst []byte, value float64, bits int) []byte {
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	dst = strconv.AppendFloat(dst, value, format, -1, bits)
+	if format == 'e' {
+		// Clean up e-09 to e-9
+		size := len(dst)
+		if size >= 4 && dst[size-4] == 'e' && dst[size-3] == '-' && dst[size-2] == '0' {
+			dst[size-2] = dst[size-1]
+			dst = dst[:size-1]
+		}
+	}
+	return dst
+}
+
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends quoted string the same way as encoding/json, including HTML escaping
+func appendJSONString(dst []byte, text string) []byte {
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(text); {
+		if char := text[i]; char < utf8.RuneSelf {
+			if char >= 0x20 && char != '"' && char != '\\' && char != '<' && char != '>' && char != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, text[start:i]...)
+			switch char {
+			case '"', '\\':
+				dst = append(dst, '\\', char)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hexDigits[char>>4], hexDigits[char&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		char, size := utf8.DecodeRuneInString(text[i:])
+		if char == utf8.RuneError && size == 1 {
+			dst = append(dst, text[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 break JSONP, so encoding/json escapes them too
+		if char == '\u2028' || char == '\u2029' {
+			dst = append(dst, text[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hexDigits[char&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, text[start:]...)
+	return append(dst, '"')
+}
+
+// isJSONSafe reports whether text can be quoted as JSON string without escaping
+func isJSONSafe(text []byte) bool {
+	for _, char := range text {
+		if char < 0x20 || char >= utf8.RuneSelf || char == '"' || char == '\\' || char == '<' || char == '>' || char == '&' {
+			return false
+		}
+	}
+	return true
+}
+
+// unquoteJSONFast returns the content of JSON string without escape sequence,
+// other strings must be decoded by encoding/json
+func unquoteJSONFast(data []byte) ([]byte, bool) {
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return nil, false
+	}
+	content := data[1 : len(data)-1]
+	for _, char := range content {
+		if char < 0x20 || char == '"' || char == '\\' {
+			return nil, false
+		}
+	}
+	if !utf8.Valid(content) {
+		return nil, false
+	}
+	return content, true
+}
+
+// isJSONNumber reports whether data is a valid JSON number, when integer is true
+// fraction and exponent are not allowed
+func isJSONNumber(data []byte, integer bool) bool {
+	i := 0
+	if i < len(data) && data[i] == '-' {
+		i++
+	}
+	if i == len(data) {
+		return false
+	}
+
+	switch {
+	case data[i] == '0':
+		i++
+	case '1' <= data[i] && data[i] <= '9':
+		for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+			i++
+		}
+	default:
+		return false
+	}
+	if integer {
+		return i == len(data)
+	}
+
+	if i < len(data) && data[i] == '.' {
+		i++
+		if i == len(data) || data[i] < '0' || data[i] > '9' {
+			return false
+		}
+		for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+			i++
+		}
+	}
+	if i < len(data) && (data[i] == 'e' || data[i] == 'E') {
+		i++
+		if i < len(data) && (data[i] == '+' || data[i] == '-') {
+			i++
+		}
+		if i == len(data) || data[i] < '0' || data[i] > '9' {
+			return false
+		}
+		for i < len(data) && '0' <= data[i] && data[i] <= '9' {
+			i++
+		}
+	}
+	return i == len(data)
+}
+
+// parseJSONInt parses JSON integer that fits into given bits, anything else
+// must be decoded by encoding/json to get the same result and error
+func parseJSONInt(data []byte, bits int) (int64, bool) {
+	if !isJSONNumber(data, true) {
+		return 0, false
+	}
+	parsed, err := strconv.ParseInt(string(data), 10, bits)
+	return parsed, err == nil
+}
+
+// parseJSONUint parses JSON unsigned integer that fits into given bits, anything else
+// must be decoded by encoding/json to get the same result and error
+func parseJSONUint(data []byte, bits int) (uint64, bool) {
+	if !isJSONNumber(data, true) {
+		return 0, false
+	}
+	parsed, err := strconv.ParseUint(string(data), 10, bits)
+	return parsed, err == nil
+}
+
+// parseJSONFloat parses JSON number that fits into given bits, anything else
+// must be decoded by encoding/json to get the same result and error
+func parseJSONFloat(data []byte, bits int) (float64, bool) {
+	if !isJSONNumber(data, false) {
+		return 0, false
+	}
+	parsed, err := strconv.ParseFloat(string(data), bits)
+	return parsed, err == nil
+}
@@ -2,7 +2,10 @@ package nullable_test
 
 import (
 	"encoding/json"
+	"math"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Thor-x86/nullable"
 	"gorm.io/gorm/utils/tests"
@@ -139,3 +142,242 @@ func marshalUnmarshalJSON(t *testing.T, target interface{}) {
 		t.Fatalf("%T is not registered at json_test.go", target)
 	}
 }
+
+func TestLenientJSON(t *testing.T) {
+	nullable.SetJSONDecoding(nullable.LenientJSON)
+	defer nullable.SetJSONDecoding(nullable.StrictJSON)
+
+	type Payload struct {
+		Count   nullable.Int
+		Small   nullable.Uint8
+		Level   nullable.Byte
+		Big     nullable.Uint64
+		Ratio   nullable.Float64
+		Active  nullable.Bool
+		Deleted nullable.Bool
+		Enabled nullable.Bool
+		Name    nullable.String
+		Created nullable.Time
+		Updated nullable.Time
+		Missing nullable.Int64
+	}
+
+	input := `{
+		"Count": "42",
+		"Small": 7,
+		"Level": "200",
+		"Big": "18446744073709551615",
+		"Ratio": "0.25",
+		"Active": "true",
+		"Deleted": 0,
+		"Enabled": "yes",
+		"Name": 12345,
+		"Created": 1630922400,
+		"Updated": "2021-09-06 10:00:00",
+		"Missing": null
+	}`
+
+	var payload Payload
+	if err := json.Unmarshal([]byte(input), &payload); err != nil {
+		t.Fatalf("Failed to unmarshal lenient JSON because: %s", err)
+	}
+
+	tests.AssertEqual(t, payload.Count.Get(), 42)
+	tests.AssertEqual(t, payload.Small.Get(), 7)
+	tests.AssertEqual(t, payload.Level.Get(), 200)
+	tests.AssertEqual(t, payload.Big.Get(), uint64(18446744073709551615))
+	tests.AssertEqual(t, payload.Ratio.Get(), 0.25)
+	tests.AssertEqual(t, payload.Active.Get(), true)
+	tests.AssertEqual(t, payload.Deleted.Get(), false)
+	tests.AssertEqual(t, payload.Enabled.Get(), true)
+	tests.AssertEqual(t, payload.Name.Get(), "12345")
+	tests.AssertEqual(t, payload.Created.Get().Unix(), int64(1630922400))
+	tests.AssertEqual(t, payload.Updated.Get().Unix(), int64(1630922400))
+	tests.AssertEqual(t, payload.Missing.Get(), nil)
+}
+
+func TestLenientJSONInvalid(t *testing.T) {
+	nullable.SetJSONDecoding(nullable.LenientJSON)
+	defer nullable.SetJSONDecoding(nullable.StrictJSON)
+
+	var nullableInt nullable.Int
+	var nullableBool nullable.Bool
+	var nullableByte nullable.Byte
+	var nullableTime nullable.Time
+
+	invalids := []struct {
+		target json.Unmarshaler
+		data   string
+	}{
+		{&nullableInt, `"ten"`},
+		{&nullableInt, `4.2`},
+		{&nullableInt, `[1]`},
+		{&nullableInt, `{"value":1}`},
+		{&nullableBool, `2`},
+		{&nullableBool, `"maybe"`},
+		{&nullableByte, `256`},
+		{&nullableTime, `1630922400.5`},
+		{&nullableTime, `"yesterday"`},
+	}
+
+	for _, invalid := range invalids {
+		if err := invalid.target.UnmarshalJSON([]byte(invalid.data)); err == nil {
+			t.Errorf("Expected error while unmarshalling %s into %T", invalid.data, invalid.target)
+		}
+	}
+}
+
+func TestStrictJSON(t *testing.T) {
+	var nullableInt8 nullable.Int8
+	var nullableBool nullable.Bool
+	var nullableTime nullable.Time
+
+	invalids := []struct {
+		target json.Unmarshaler
+		data   string
+	}{
+		{&nullableInt8, `"42"`},
+		{&nullableBool, `1`},
+		{&nullableBool, `"yes"`},
+		{&nullableTime, `1630922400`},
+		{&nullableTime, `"2021-09-06 10:00:00"`},
+	}
+
+	for _, invalid := range invalids {
+		if err := invalid.target.UnmarshalJSON([]byte(invalid.data)); err == nil {
+			t.Errorf("Expected error while unmarshalling %s into %T", invalid.data, invalid.target)
+		}
+	}
+}
+
+type jsonAppender interface {
+	AppendJSON(dst []byte) []byte
+}
+
+func TestAppendJSON(t *testing.T) {
+	strings := []string{"", "plain", `quote " and \ backslash`, "<script>&</script>", "tab\tnew\nline\x00\x1f", "  ", "日本語", "bad \xff utf-8"}
+	for _, basicString := range strings {
+		expected, _ := json.Marshal(basicString)
+		tests.AssertEqual(t, string(nullable.NewString(&basicString).AppendJSON(nil)), string(expected))
+	}
+
+	floats := []float64{0, -0.0, 1, -1.5, 0.1, 1e-7, 123456789, 1e20, 1e21, 1.7976931348623157e308, 5e-324, math.Pi}
+	for _, basicFloat := range floats {
+		expected, _ := json.Marshal(basicFloat)
+		tests.AssertEqual(t, string(nullable.NewFloat64(&basicFloat).AppendJSON(nil)), string(expected))
+
+		basicFloat32 := float32(basicFloat)
+		if math.IsInf(float64(basicFloat32), 0) {
+			continue
+		}
+		expected, _ = json.Marshal(basicFloat32)
+		tests.AssertEqual(t, string(nullable.NewFloat32(&basicFloat32).AppendJSON(nil)), string(expected))
+	}
+
+	basicTime := time.Date(2021, 9, 6, 10, 0, 0, 123000000, time.FixedZone("WIB", 7*60*60))
+	expected, _ := json.Marshal(basicTime)
+	tests.AssertEqual(t, string(nullable.NewTime(&basicTime).AppendJSON(nil)), string(expected))
+
+	basicBytes := []byte{0, 1, 0xfe, 0xff}
+	expected, _ = json.Marshal(basicBytes)
+	tests.AssertEqual(t, string(nullable.NewBytes(&basicBytes).AppendJSON(nil)), string(expected))
+
+	var basicInt64 int64 = math.MinInt64
+	var basicUint64 uint64 = math.MaxUint64
+	basicBool := true
+	appenders := []jsonAppender{
+		nullable.NewInt64(&basicInt64), nullable.NewUint64(&basicUint64), nullable.NewBool(&basicBool),
+		nullable.NewInt64String(&basicInt64), nullable.NewTimeUnixMilli(&basicTime), nullable.NewBytesHex(&basicBytes),
+		nullable.NewInt8(nil), nullable.NewString(nil), nullable.NewTime(nil), nullable.NewBytes(nil),
+	}
+	for _, appender := range appenders {
+		expected, _ := json.Marshal(appender)
+		tests.AssertEqual(t, string(appender.AppendJSON([]byte("prefix:"))), "prefix:"+string(expected))
+	}
+}
+
+func TestAppendJSONLayout(t *testing.T) {
+	basicTime := time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+
+	nullable.SetTimeLayouts(`"Monday" <2006>`)
+	defer nullable.SetTimeLayouts()
+
+	tests.AssertEqual(t, string(nullable.NewTime(&basicTime).AppendJSON(nil)), `"\"Monday\" \u003c2021\u003e"`)
+}
+
+func TestAppendJSONAllocs(t *testing.T) {
+	var basicInt64 int64 = 9007199254740993
+	basicString := "nullable"
+	basicFloat := 1.5
+	basicTime := time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+	appenders := []jsonAppender{
+		nullable.NewInt64(&basicInt64), nullable.NewString(&basicString),
+		nullable.NewFloat64(&basicFloat), nullable.NewTime(&basicTime),
+	}
+
+	buffer := make([]byte, 0, 64)
+	for _, appender := range appenders {
+		allocs := testing.AllocsPerRun(100, func() {
+			buffer = appender.AppendJSON(buffer[:0])
+		})
+		if allocs != 0 {
+			t.Errorf("Expected AppendJSON of %T to not allocate, got %v allocations", appender, allocs)
+		}
+	}
+
+	var nullableInt64 nullable.Int64
+	data := []byte("9007199254740993")
+	allocs := testing.AllocsPerRun(100, func() {
+		nullableInt64.UnmarshalJSON(data)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected UnmarshalJSON of Int64 to not allocate, got %v allocations", allocs)
+	}
+}
+
+func TestUnmarshalJSONFastPath(t *testing.T) {
+	valids := []struct {
+		target   json.Unmarshaler
+		data     string
+		expected interface{}
+	}{
+		{&nullable.Int8{}, "-128", int8(-128)},
+		{&nullable.Int64{}, "-0", int64(0)},
+		{&nullable.Uint16{}, "65535", uint16(65535)},
+		{&nullable.Float64{}, "-1.5e-3", -1.5e-3},
+		{&nullable.Float32{}, "0.1", float32(0.1)},
+		{&nullable.Bool{}, "false", false},
+		{&nullable.String{}, `"plain"`, "plain"},
+		{&nullable.String{}, `"escaped \"é\" \n"`, "escaped \"é\" \n"},
+		{&nullable.String{}, "\"bad \xff utf-8\"", "bad � utf-8"},
+	}
+	for _, valid := range valids {
+		if err := valid.target.UnmarshalJSON([]byte(valid.data)); err != nil {
+			t.Errorf("Failed to unmarshal %s into %T because: %s", valid.data, valid.target, err)
+			continue
+		}
+		value := reflect.ValueOf(valid.target).MethodByName("Get").Call(nil)[0]
+		tests.AssertEqual(t, value.Elem().Interface(), valid.expected)
+	}
+
+	invalids := []struct {
+		target json.Unmarshaler
+		data   string
+	}{
+		{&nullable.Int8{}, "128"},
+		{&nullable.Int16{}, "01"},
+		{&nullable.Int32{}, "1.0"},
+		{&nullable.Int32{}, "1e3"},
+		{&nullable.Uint32{}, "-1"},
+		{&nullable.Float32{}, "1e39"},
+		{&nullable.Float64{}, ".5"},
+		{&nullable.Float64{}, "0x10"},
+		{&nullable.Bool{}, "True"},
+		{&nullable.String{}, "plain"},
+	}
+	for _, invalid := range invalids {
+		if err := invalid.target.UnmarshalJSON([]byte(invalid.data)); err == nil {
+			t.Errorf("Expected error while unmarshalling %s into %T", invalid.data, invalid.target)
+		}
+	}
+}
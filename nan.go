@@ -0,0 +1,123 @@
+package nullable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+)
+
+// NaNPolicy decides what happens to NaN and Infinity of Float32 and Float64
+// in MarshalJSON, UnmarshalJSON, and Value
+type NaNPolicy int32
+
+const (
+	// NaNError refuses NaN and Infinity like encoding/json does, this is the default
+	NaNError NaNPolicy = iota
+	// NaNNull treats NaN and Infinity as NULL
+	NaNNull
+	// NaNString writes NaN and Infinity to JSON as "NaN", "Infinity", and "-Infinity",
+	// then passes them to SQL where GormValue refuses dialects that can't store them
+	NaNString
+)
+
+var nanPolicy int32
+
+// ErrNonFinite is wrapped by every error caused by NaN or Infinity
+var ErrNonFinite = errors.New("NaN and Infinity are not allowed")
+
+// SetNaNPolicy changes how every Float32 and Float64 handles NaN and Infinity
+func SetNaNPolicy(policy NaNPolicy) {
+	atomic.StoreInt32(&nanPolicy, int32(policy))
+}
+
+func currentNaNPolicy() NaNPolicy {
+	return NaNPolicy(atomic.LoadInt32(&nanPolicy))
+}
+
+func isNonFinite(value float64) bool {
+	return math.IsNaN(value) || math.IsInf(value, 0)
+}
+
+// checkNonFinite applies current policy to a valid float, NaN and Infinity become either NULL or error
+func checkNonFinite(value float64) (isValid bool, err error) {
+	if !isNonFinite(value) {
+		return true, nil
+	}
+	switch currentNaNPolicy() {
+	case NaNNull:
+		return false, nil
+	case NaNString:
+		return true, nil
+	}
+	return false, fmt.Errorf("%w: got %v", ErrNonFinite, value)
+}
+
+// marshalNonFiniteJSON writes NaN or Infinity to JSON by current policy
+func marshalNonFiniteJSON(value float64) ([]byte, error) {
+	if _, err := checkNonFinite(value); err != nil {
+		return nil, err
+	}
+	return appendNonFiniteJSON(nil, value), nil
+}
+
+// appendNonFiniteJSON appends NaN or Infinity by current policy, refused value becomes null
+func appendNonFiniteJSON(dst []byte, value float64) []byte {
+	if isValid, _ := checkNonFinite(value); !isValid {
+		return append(dst, "null"...)
+	}
+
+	switch {
+	case math.IsNaN(value):
+		return append(dst, `"NaN"`...)
+	case value > 0:
+		return append(dst, `"Infinity"`...)
+	}
+	return append(dst, `"-Infinity"`...)
+}
+
+// parseNonFiniteJSON reads exactly the quoted NaN, Infinity, or -Infinity which
+// appendNonFiniteJSON writes, any other string like "nan" or "+Inf" is refused
+func parseNonFiniteJSON(data []byte) (float64, error) {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return 0, err
+	}
+
+	switch text {
+	case "NaN":
+		return math.NaN(), nil
+	case "Infinity":
+		return math.Inf(1), nil
+	case "-Infinity":
+		return math.Inf(-1), nil
+	}
+	return 0, fmt.Errorf("json: cannot unmarshal string %s into float, only NaN and Infinity can be quoted", data)
+}
+
+// checkNonFiniteSQL refuses NaN and Infinity which the dialect can't store
+func checkNonFiniteSQL(value float64, dialect string) error {
+	switch dialect {
+	case "mysql":
+		// MySQL has neither NaN nor Infinity
+		if isNonFinite(value) {
+			return fmt.Errorf("%w: mysql can't store %v", ErrNonFinite, value)
+		}
+	case "sqlite":
+		// SQLite silently stores NaN as NULL
+		if math.IsNaN(value) {
+			return fmt.Errorf("%w: sqlite can't store %v", ErrNonFinite, value)
+		}
+	}
+	return nil
+}
+
+// floatSchema allows NaN and Infinity strings when current policy writes them
+func floatSchema(described map[string]interface{}) map[string]interface{} {
+	if currentNaNPolicy() == NaNString {
+		described["type"] = []string{"number", "string", "null"}
+		described["pattern"] = "^(NaN|-?Infinity)$"
+	}
+	return described
+}
@@ -0,0 +1,79 @@
+package nullable
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Direction of ORDER BY
+type Direction int
+
+const (
+	// Asc sorts from the smallest value
+	Asc Direction = iota
+	// Desc sorts from the largest value
+	Desc
+)
+
+// OrderByColumn is a single column of OrderByColumns
+type OrderByColumn struct {
+	// Column is the name of column, like "score" or "users.score"
+	Column    string
+	Direction Direction
+	Nulls     NullOrder
+}
+
+// OrderBy creates GORM clause which sorts by column and puts NULL first or last on every dialect:
+//
+//	db.Clauses(nullable.OrderBy("score", nullable.Desc, nullable.NullsLast)).Find(&users)
+func OrderBy(column string, direction Direction, nulls NullOrder) clause.OrderBy {
+	return OrderByColumns(OrderByColumn{Column: column, Direction: direction, Nulls: nulls})
+}
+
+// OrderByColumns is OrderBy with multiple columns, because GORM keeps only the last OrderBy clause
+func OrderByColumns(columns ...OrderByColumn) clause.OrderBy {
+	return clause.OrderBy{Expression: orderByNulls(columns)}
+}
+
+// orderByNulls builds native NULLS FIRST/LAST on PostgreSQL, or the "column IS NULL" emulation elsewhere
+type orderByNulls []OrderByColumn
+
+func (columns orderByNulls) Build(builder clause.Builder) {
+	dialect := ""
+	if statement, isStatement := builder.(*gorm.Statement); isStatement {
+		dialect = statement.DB.Dialector.Name()
+	}
+
+	for i, column := range columns {
+		if i > 0 {
+			builder.WriteByte(',')
+		}
+		quoted := clause.Column{Name: column.Column}
+
+		switch dialect {
+		case "postgres":
+			builder.WriteQuoted(quoted)
+			if column.Direction == Desc {
+				builder.WriteString(" DESC")
+			}
+			if column.Nulls == NullsFirst {
+				builder.WriteString(" NULLS FIRST")
+			} else {
+				builder.WriteString(" NULLS LAST")
+			}
+		default:
+			// MySQL, SQLite before 3.30, and the rest don't have NULLS FIRST/LAST,
+			// but "IS NULL" is 0 for valid values and 1 for NULL
+			builder.WriteQuoted(quoted)
+			builder.WriteString(" IS NULL")
+			if column.Nulls == NullsFirst {
+				builder.WriteString(" DESC")
+			}
+			builder.WriteByte(',')
+			builder.WriteQuoted(quoted)
+			if column.Direction == Desc {
+				builder.WriteString(" DESC")
+			}
+		}
+	}
+}
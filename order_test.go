@@ -0,0 +1,87 @@
+package nullable_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+)
+
+type TestOrderByPlayer struct {
+	ID    uint
+	Name  string
+	Score nullable.Int64
+	Level nullable.Int64
+}
+
+func orderedPlayerNames(t *testing.T, db *gorm.DB) []string {
+	t.Helper()
+	var players []TestOrderByPlayer
+	if err := db.Find(&players).Error; err != nil {
+		t.Fatalf("Failed to query ordered players because: %s", err)
+	}
+	names := make([]string, len(players))
+	for i, player := range players {
+		names[i] = player.Name
+	}
+	return names
+}
+
+func TestOrderBy(t *testing.T) {
+	DB.Migrator().DropTable(&TestOrderByPlayer{})
+	if err := DB.Migrator().AutoMigrate(&TestOrderByPlayer{}); err != nil {
+		t.Fatalf("failed to migrate order by players, got error: %v", err)
+	}
+
+	DB.Create(&[]TestOrderByPlayer{
+		{Name: "thor", Score: nullable.NewInt64Value(30), Level: nullable.NewInt64Value(1)},
+		{Name: "loki", Score: nullable.NewInt64(nil), Level: nullable.NewInt64Value(2)},
+		{Name: "odin", Score: nullable.NewInt64Value(90), Level: nullable.NewInt64(nil)},
+		{Name: "frigg", Score: nullable.NewInt64Value(30), Level: nullable.NewInt64Value(3)},
+	})
+
+	names := orderedPlayerNames(t, DB.Clauses(nullable.OrderBy("score", nullable.Desc, nullable.NullsLast)))
+	tests.AssertEqual(t, names[0], "odin")
+	tests.AssertEqual(t, names[3], "loki")
+
+	names = orderedPlayerNames(t, DB.Clauses(nullable.OrderBy("score", nullable.Asc, nullable.NullsFirst)))
+	tests.AssertEqual(t, names[0], "loki")
+	tests.AssertEqual(t, names[3], "odin")
+
+	names = orderedPlayerNames(t, DB.Clauses(nullable.OrderByColumns(
+		nullable.OrderByColumn{Column: "score", Direction: nullable.Asc, Nulls: nullable.NullsLast},
+		nullable.OrderByColumn{Column: "level", Direction: nullable.Desc, Nulls: nullable.NullsLast},
+	)))
+	tests.AssertEqual(t, names, []string{"frigg", "thor", "odin", "loki"})
+
+	var players []TestOrderByPlayer
+	statement := DB.Session(&gorm.Session{DryRun: true}).Clauses(nullable.OrderBy("score", nullable.Desc, nullable.NullsFirst)).Find(&players).Statement
+	if query := statement.SQL.String(); DB.Dialector.Name() != "postgres" && !strings.Contains(query, "score` IS NULL DESC,") && !strings.Contains(query, `score" IS NULL DESC,`) {
+		t.Errorf("Expected IS NULL emulation, got %s", query)
+	}
+}
+
+func TestOrderByPostgres(t *testing.T) {
+	connection, err := DB.DB()
+	if err != nil {
+		t.Fatalf("Failed to get connection because: %s", err)
+	}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: connection}), &gorm.Config{DryRun: true})
+	if err != nil {
+		t.Fatalf("Failed to open postgres dialector because: %s", err)
+	}
+
+	var players []TestOrderByPlayer
+	statement := db.Clauses(nullable.OrderByColumns(
+		nullable.OrderByColumn{Column: "score", Direction: nullable.Desc, Nulls: nullable.NullsLast},
+		nullable.OrderByColumn{Column: "level", Direction: nullable.Asc, Nulls: nullable.NullsFirst},
+	)).Find(&players).Statement
+
+	query := statement.SQL.String()
+	if !strings.HasSuffix(query, `ORDER BY "score" DESC NULLS LAST,"level" NULLS FIRST`) {
+		t.Errorf("Expected native NULLS FIRST/LAST, got %s", query)
+	}
+}
@@ -0,0 +1,199 @@
+package nullable
+
+import (
+	"database/sql"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The scan helpers convert the values which pgx, go-sql-driver/mysql, and
+// sqlite3 actually return without reflection. Anything else goes through
+// convertAssign, so it is converted the same way as database/sql. Every
+// failure is *ScanError of given target.
+//
+// UnmarshalJSON follows the same idea with the parseJSON helpers, and falls
+// back to encoding/json only for unusual input. The fallback decodes into its
+// own variable, because taking the address of the fast path result would move
+// it to the heap on every call.
+
+// scanInt converts value into signed integer that fits into given bits
+func scanInt(value interface{}, bits int, target interface{}) (int64, error) {
+	var scanned int64
+	switch typed := value.(type) {
+	case int64:
+		scanned = typed
+	case []byte:
+		parsed, err := strconv.ParseInt(string(typed), 10, bits)
+		if err != nil {
+			return 0, newParseError(value, target, err)
+		}
+		return parsed, nil
+	case string:
+		parsed, err := strconv.ParseInt(typed, 10, bits)
+		if err != nil {
+			return 0, newParseError(value, target, err)
+		}
+		return parsed, nil
+	default:
+		var converted int64
+		if err := convertAssign(&converted, value); err != nil {
+			return 0, newFallbackError(value, target, err)
+		}
+		scanned = converted
+	}
+
+	if scanned != scanned<<(64-bits)>>(64-bits) {
+		return 0, newOverflowError(value, target)
+	}
+	return scanned, nil
+}
+
+// scanUint converts value into unsigned integer that fits into given bits
+func scanUint(value interface{}, bits int, target interface{}) (uint64, error) {
+	limit := uint64(math.MaxUint64) >> (64 - bits)
+	switch typed := value.(type) {
+	case int64:
+		if typed < 0 || uint64(typed) > limit {
+			return 0, newOverflowError(value, target)
+		}
+		return uint64(typed), nil
+	case uint64:
+		if typed > limit {
+			return 0, newOverflowError(value, target)
+		}
+		return typed, nil
+	case []byte:
+		parsed, err := parseScannedUint(string(typed), bits)
+		if err != nil {
+			return 0, newParseError(value, target, err)
+		}
+		return parsed, nil
+	case string:
+		parsed, err := parseScannedUint(typed, bits)
+		if err != nil {
+			return 0, newParseError(value, target, err)
+		}
+		return parsed, nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return 0, newFallbackError(value, target, err)
+	}
+	if strings.HasPrefix(scanned, "-") {
+		return 0, newOverflowError(value, target)
+	}
+	parsed, err := parseScannedUint(scanned, bits)
+	if err != nil {
+		return 0, newParseError(value, target, err)
+	}
+	return parsed, nil
+}
+
+// parseScannedUint parses decimal text, or binary text with exactly given bits
+// which PostgreSQL returns for values written by GormValue
+func parseScannedUint(text string, bits int) (uint64, error) {
+	radix := 10
+	if len(text) == bits {
+		radix = 2
+	}
+	return strconv.ParseUint(text, radix, bits)
+}
+
+// scanFloat converts value into float that fits into given bits
+func scanFloat(value interface{}, bits int, target interface{}) (float64, error) {
+	var scanned float64
+	switch typed := value.(type) {
+	case float64:
+		scanned = typed
+	case int64:
+		scanned = float64(typed)
+	case []byte:
+		parsed, err := strconv.ParseFloat(string(typed), bits)
+		if err != nil {
+			return 0, newParseError(value, target, err)
+		}
+		return parsed, nil
+	case string:
+		parsed, err := strconv.ParseFloat(typed, bits)
+		if err != nil {
+			return 0, newParseError(value, target, err)
+		}
+		return parsed, nil
+	default:
+		var converted float64
+		if err := convertAssign(&converted, value); err != nil {
+			return 0, newFallbackError(value, target, err)
+		}
+		scanned = converted
+	}
+
+	if bits == 32 && !math.IsInf(scanned, 0) && math.IsInf(float64(float32(scanned)), 0) {
+		return 0, newOverflowError(value, target)
+	}
+	return scanned, nil
+}
+
+// scanBool converts value into boolean
+func scanBool(value interface{}, target interface{}) (bool, error) {
+	switch typed := value.(type) {
+	case bool:
+		return typed, nil
+	case int64:
+		if typed == 0 || typed == 1 {
+			return typed == 1, nil
+		}
+	case []byte:
+		if parsed, err := strconv.ParseBool(string(typed)); err == nil {
+			return parsed, nil
+		}
+	case string:
+		if parsed, err := strconv.ParseBool(typed); err == nil {
+			return parsed, nil
+		}
+	}
+
+	var scanned bool
+	if err := convertAssign(&scanned, value); err != nil {
+		return false, newFallbackError(value, target, err)
+	}
+	return scanned, nil
+}
+
+// scanString converts value into string
+func scanString(value interface{}, target interface{}) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case []byte:
+		return string(typed), nil
+	case int64:
+		return strconv.FormatInt(typed, 10), nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return "", newFallbackError(value, target, err)
+	}
+	return scanned, nil
+}
+
+// scanBytes converts value into a copy of bytes
+func scanBytes(value interface{}, target interface{}) ([]byte, error) {
+	switch typed := value.(type) {
+	case []byte:
+		return cloneBytes(typed), nil
+	case sql.RawBytes:
+		// convertAssign would keep the driver buffer, which is reused on the next row
+		return cloneBytes(typed), nil
+	case string:
+		return []byte(typed), nil
+	}
+
+	var scanned []byte
+	if err := convertAssign(&scanned, value); err != nil {
+		return nil, newFallbackError(value, target, err)
+	}
+	return scanned, nil
+}
@@ -0,0 +1,119 @@
+package nullable
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// scanSources are the values returned by common drivers, plus a few which
+// only convertAssign can handle
+var scanSources = []interface{}{
+	int64(0), int64(1), int64(-1), int64(127), int64(128), int64(255), int64(256),
+	int64(math.MaxInt32), int64(math.MaxInt32 + 1), int64(math.MinInt64), int64(math.MaxInt64),
+	uint64(math.MaxUint64), 1.5, -2.0, 1e300, math.Inf(1),
+	[]byte("42"), []byte("-42"), []byte("1.25"), []byte("yes"), []byte("true"), []byte("abc"), []byte(""),
+	"42", "-42", "18446744073709551615", "11111111", "1.25", "NO", "f", "abc", "",
+	true, false, time.Unix(1630922400, 0).UTC(), int32(7), float32(2.5),
+}
+
+// assertSameScan makes sure the fast path gives the same result and error as convertAssign
+func assertSameScan(t *testing.T, source interface{}, fast interface{}, fastErr error, reference interface{}, referenceErr error) {
+	t.Helper()
+	if (fastErr == nil) != (referenceErr == nil) {
+		t.Errorf("Scanning %T(%v): fast error %v, reference error %v", source, source, fastErr, referenceErr)
+		return
+	}
+	if fastErr == nil && !reflect.DeepEqual(fast, reference) {
+		t.Errorf("Scanning %T(%v): fast %#v, reference %#v", source, source, fast, reference)
+	}
+}
+
+func TestScanFastPath(t *testing.T) {
+	for _, source := range scanSources {
+		for _, bits := range []int{64, strconv.IntSize} {
+			fast, fastErr := scanInt(source, bits, new(Int64))
+			var reference int64
+			referenceErr := convertAssign(&reference, source)
+			assertSameScan(t, source, fast, fastErr, reference, referenceErr)
+		}
+
+		for _, bits := range []int{8, 16, 32, 64} {
+			fast, fastErr := scanUint(source, bits, new(Uint64))
+			var text string
+			reference, referenceErr := uint64(0), convertAssign(&text, source)
+			if referenceErr == nil {
+				reference, referenceErr = parseScannedUint(text, bits)
+			}
+			assertSameScan(t, source, fast, fastErr, reference, referenceErr)
+		}
+
+		fastFloat, fastErr := scanFloat(source, 64, new(Float64))
+		var referenceFloat float64
+		referenceErr := convertAssign(&referenceFloat, source)
+		assertSameScan(t, source, fastFloat, fastErr, referenceFloat, referenceErr)
+
+		fastString, fastErr := scanString(source, new(String))
+		var referenceString string
+		referenceErr = convertAssign(&referenceString, source)
+		assertSameScan(t, source, fastString, fastErr, referenceString, referenceErr)
+
+		fastBytes, fastErr := scanBytes(source, new(Bytes))
+		var referenceBytes []byte
+		referenceErr = convertAssign(&referenceBytes, source)
+		assertSameScan(t, source, fastBytes, fastErr, referenceBytes, referenceErr)
+
+		fastBool, fastErr := scanBool(source, new(Bool))
+		var referenceBool bool
+		referenceErr = convertAssign(&referenceBool, source)
+		assertSameScan(t, source, fastBool, fastErr, referenceBool, referenceErr)
+	}
+}
+
+func TestScanFastPathError(t *testing.T) {
+	// Failed fast conversion keeps the parsing error as reason
+	_, err := scanInt([]byte("abc"), 64, new(Int64))
+	var scanError *ScanError
+	if !errors.As(err, &scanError) || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Expected ScanError with syntax error while scanning abc, got %v", err)
+	}
+
+	if _, err := scanUint("256", 8, new(Uint8)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow while scanning 256 into 8 bits, got %v", err)
+	}
+}
+
+func TestScanAllocs(t *testing.T) {
+	var nullableInt64 Int64
+	var nullableUint64 Uint64
+	var nullableFloat32 Float32
+	var nullableBool Bool
+	var nullableTime Time
+
+	sources := []struct {
+		target interface{ Scan(interface{}) error }
+		value  interface{}
+	}{
+		{&nullableInt64, int64(9007199254740993)},
+		{&nullableInt64, []byte("9007199254740993")},
+		{&nullableUint64, int64(9007199254740993)},
+		{&nullableUint64, []byte("9007199254740993")},
+		{&nullableFloat32, 1.5},
+		{&nullableBool, true},
+		{&nullableBool, int64(1)},
+		{&nullableTime, time.Unix(1630922400, 0)},
+	}
+
+	for _, source := range sources {
+		allocs := testing.AllocsPerRun(100, func() {
+			source.target.Scan(source.value)
+		})
+		if allocs != 0 {
+			t.Errorf("Expected scanning %s into %T to not allocate, got %v allocations", fmt.Sprintf("%T", source.value), source.target, allocs)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Package schema generates JSON Schema and OpenAPI schemas of Go types,
+// including structs with nullable fields.
+//
+// Nullable types describe themselves through their JSONSchema method, so they
+// are shown with their real JSON form instead of empty objects:
+//
+//	type User struct {
+//		ID  uint64         `json:"id"`
+//		Age nullable.Uint8 `json:"age"`
+//	}
+//
+//	userSchema := schema.Generate(reflect.TypeOf(User{}))
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Version is the dialect of generated schema
+type Version int
+
+const (
+	// JSONSchema is JSON Schema 2020-12, which is also used by OpenAPI 3.1.
+	// Nullable values are described as `"type": ["integer", "null"]`.
+	JSONSchema Version = iota
+	// OpenAPI30 is the schema object of OpenAPI 3.0.
+	// Nullable values are described as `"type": "integer", "nullable": true`.
+	OpenAPI30
+)
+
+// Describer is implemented by types which describe their own JSON form,
+// in JSON Schema dialect. Every nullable type implements it.
+type Describer interface {
+	JSONSchema() map[string]interface{}
+}
+
+var (
+	describerType     = reflect.TypeOf((*Describer)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// Generator generates schemas of Go types, the zero value generates JSON Schema
+type Generator struct {
+	Version Version
+}
+
+// Generate generates JSON Schema (OpenAPI 3.1) of given type
+func Generate(t reflect.Type) map[string]interface{} {
+	return (&Generator{}).Generate(t)
+}
+
+// Generate generates schema of given type in the dialect of g.Version
+func (g *Generator) Generate(t reflect.Type) map[string]interface{} {
+	generated := describe(t, map[reflect.Type]bool{})
+	if g.Version == OpenAPI30 {
+		downgrade(generated)
+	}
+	return generated
+}
+
+// describe generates JSON Schema of t, visiting guards against recursive types
+func describe(t reflect.Type, visiting map[reflect.Type]bool) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		return nullify(describe(t.Elem(), visiting))
+	}
+	if reflect.PtrTo(t).Implements(describerType) {
+		return reflect.New(t).Interface().(Describer).JSONSchema()
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	}
+
+	// Custom JSON form can't be guessed, unless it is plain text
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return map[string]interface{}{}
+	}
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": []string{"string", "null"}, "format": "byte", "contentEncoding": "base64"}
+		}
+		return nullify(map[string]interface{}{"type": "array", "items": describe(t.Elem(), visiting)})
+	case reflect.Array:
+		return map[string]interface{}{
+			"type":     "array",
+			"items":    describe(t.Elem(), visiting),
+			"minItems": t.Len(),
+			"maxItems": t.Len(),
+		}
+	case reflect.Map:
+		return nullify(map[string]interface{}{"type": "object", "additionalProperties": describe(t.Elem(), visiting)})
+	case reflect.Struct:
+		if visiting[t] {
+			return map[string]interface{}{"type": "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		properties := map[string]interface{}{}
+		var required []string
+		describeFields(t, visiting, properties, &required)
+
+		described := map[string]interface{}{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			described["required"] = required
+		}
+		return described
+	}
+	return map[string]interface{}{}
+}
+
+// describeFields adds the fields of struct t the same way encoding/json marshals them
+func describeFields(t reflect.Type, visiting map[reflect.Type]bool, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+
+		fieldType := field.Type
+		if field.Anonymous && name == "" {
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				describeFields(fieldType, visiting, properties, required)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = describe(field.Type, visiting)
+
+		isOmitEmpty := false
+		for _, option := range parts[1:] {
+			isOmitEmpty = isOmitEmpty || option == "omitempty"
+		}
+		if !isOmitEmpty {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// nullify adds null into the allowed types
+func nullify(described map[string]interface{}) map[string]interface{} {
+	switch typed := described["type"].(type) {
+	case string:
+		described["type"] = []string{typed, "null"}
+	case []string:
+		for _, current := range typed {
+			if current == "null" {
+				return described
+			}
+		}
+		described["type"] = append(typed, "null")
+	}
+	return described
+}
+
+// downgrade converts JSON Schema into OpenAPI 3.0 schema object in place
+func downgrade(described map[string]interface{}) {
+	if types, ok := described["type"].([]string); ok {
+		var nonNull []string
+		for _, current := range types {
+			if current == "null" {
+				described["nullable"] = true
+			} else {
+				nonNull = append(nonNull, current)
+			}
+		}
+
+		switch len(nonNull) {
+		case 0:
+			delete(described, "type")
+		case 1:
+			described["type"] = nonNull[0]
+		default:
+			delete(described, "type")
+			oneOf := make([]interface{}, len(nonNull))
+			for i, current := range nonNull {
+				oneOf[i] = map[string]interface{}{"type": current}
+			}
+			described["oneOf"] = oneOf
+		}
+	}
+	delete(described, "contentEncoding")
+
+	if properties, ok := described["properties"].(map[string]interface{}); ok {
+		for _, property := range properties {
+			downgrade(property.(map[string]interface{}))
+		}
+	}
+	if items, ok := described["items"].(map[string]interface{}); ok {
+		downgrade(items)
+	}
+	if additional, ok := described["additionalProperties"].(map[string]interface{}); ok {
+		downgrade(additional)
+	}
+}
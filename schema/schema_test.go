@@ -0,0 +1,74 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+	"github.com/Thor-x86/nullable/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+type testAudit struct {
+	CreatedAt time.Time     `json:"created_at"`
+	DeletedAt nullable.Time `json:"deleted_at,omitempty"`
+}
+
+type testUser struct {
+	testAudit
+	ID       uint64          `json:"id"`
+	Age      nullable.Uint64 `json:"age"`
+	Nickname *string         `json:"nickname,omitempty"`
+	Tags     []string        `json:"tags"`
+	Avatar   nullable.Bytes  `json:"avatar"`
+	Friends  []testUser      `json:"friends,omitempty"`
+	Password string          `json:"-"`
+	internal int
+}
+
+func marshalSchema(t *testing.T, generated map[string]interface{}) string {
+	serialized, err := json.Marshal(generated)
+	if err != nil {
+		t.Fatalf("Failed to marshal schema because: %s", err)
+	}
+	return string(serialized)
+}
+
+func TestGenerate(t *testing.T) {
+	generated := schema.Generate(reflect.TypeOf(testUser{}))
+	tests.AssertEqual(t, marshalSchema(t, generated), `{"properties":{`+
+		`"age":{"format":"int64","minimum":0,"type":["integer","null"]},`+
+		`"avatar":{"contentEncoding":"base64","format":"byte","type":["string","null"]},`+
+		`"created_at":{"format":"date-time","type":"string"},`+
+		`"deleted_at":{"format":"date-time","type":["string","null"]},`+
+		`"friends":{"items":{"type":"object"},"type":["array","null"]},`+
+		`"id":{"format":"int64","minimum":0,"type":"integer"},`+
+		`"nickname":{"type":["string","null"]},`+
+		`"tags":{"items":{"type":"string"},"type":["array","null"]}},`+
+		`"required":["created_at","id","age","tags","avatar"],"type":"object"}`)
+}
+
+func TestGenerateOpenAPI30(t *testing.T) {
+	generator := schema.Generator{Version: schema.OpenAPI30}
+	generated := generator.Generate(reflect.TypeOf(testUser{}))
+	tests.AssertEqual(t, marshalSchema(t, generated), `{"properties":{`+
+		`"age":{"format":"int64","minimum":0,"nullable":true,"type":"integer"},`+
+		`"avatar":{"format":"byte","nullable":true,"type":"string"},`+
+		`"created_at":{"format":"date-time","type":"string"},`+
+		`"deleted_at":{"format":"date-time","nullable":true,"type":"string"},`+
+		`"friends":{"items":{"type":"object"},"nullable":true,"type":"array"},`+
+		`"id":{"format":"int64","minimum":0,"type":"integer"},`+
+		`"nickname":{"nullable":true,"type":"string"},`+
+		`"tags":{"items":{"type":"string"},"nullable":true,"type":"array"}},`+
+		`"required":["created_at","id","age","tags","avatar"],"type":"object"}`)
+}
+
+func TestGenerateNullableType(t *testing.T) {
+	generated := schema.Generate(reflect.TypeOf(nullable.Int8{}))
+	tests.AssertEqual(t, marshalSchema(t, generated), `{"format":"int32","maximum":127,"minimum":-128,"type":["integer","null"]}`)
+
+	generated = schema.Generate(reflect.TypeOf(&nullable.Float32{}))
+	tests.AssertEqual(t, marshalSchema(t, generated), `{"format":"float","type":["number","null"]}`)
+}
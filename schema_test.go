@@ -0,0 +1,153 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Thor-x86/nullable"
+)
+
+type jsonSchemaDescriber interface {
+	JSONSchema() map[string]interface{}
+}
+
+// assertJSONSchema makes sure the JSON form of target is allowed by its own schema
+func assertJSONSchema(t *testing.T, target jsonSchemaDescriber) {
+	serialized, err := json.Marshal(target)
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+	}
+
+	var decoded interface{}
+	json.Unmarshal(serialized, &decoded)
+
+	var kind string
+	switch typed := decoded.(type) {
+	case nil:
+		kind = "null"
+	case bool:
+		kind = "boolean"
+	case string:
+		kind = "string"
+	case float64:
+		kind = "number"
+		if typed == math.Trunc(typed) {
+			kind = "integer"
+		}
+	}
+
+	for _, allowed := range target.JSONSchema()["type"].([]string) {
+		if allowed == kind {
+			return
+		}
+	}
+	t.Errorf("JSON %s of %T is not allowed by its schema %v", serialized, target, target.JSONSchema())
+}
+
+func TestJSONSchema(t *testing.T) {
+	basicBool := true
+	var basicByte byte = 0xff
+	basicBytes := []byte{0x1}
+	var basicFloat32 float32 = 1.5
+	basicFloat64 := 1.5
+	basicInt := -1
+	var basicInt8 int8 = -1
+	var basicInt16 int16 = -1
+	var basicInt32 int32 = -1
+	var basicInt64 int64 = -1
+	basicString := "nullable"
+	basicTime := time.Now()
+	var basicUint uint = 1
+	var basicUint8 uint8 = 1
+	var basicUint16 uint16 = 1
+	var basicUint32 uint32 = 1
+	var basicUint64 uint64 = 1
+
+	targets := []jsonSchemaDescriber{
+		nullable.NewBool(&basicBool), nullable.NewBool(nil),
+		nullable.NewByte(&basicByte), nullable.NewByte(nil),
+		nullable.NewBytes(&basicBytes), nullable.NewBytes(nil),
+		nullable.NewFloat32(&basicFloat32), nullable.NewFloat32(nil),
+		nullable.NewFloat64(&basicFloat64), nullable.NewFloat64(nil),
+		nullable.NewInt(&basicInt), nullable.NewInt(nil),
+		nullable.NewInt8(&basicInt8), nullable.NewInt8(nil),
+		nullable.NewInt16(&basicInt16), nullable.NewInt16(nil),
+		nullable.NewInt32(&basicInt32), nullable.NewInt32(nil),
+		nullable.NewInt64(&basicInt64), nullable.NewInt64(nil),
+		nullable.NewString(&basicString), nullable.NewString(nil),
+		nullable.NewTime(&basicTime), nullable.NewTime(nil),
+		nullable.NewUint(&basicUint), nullable.NewUint(nil),
+		nullable.NewUint8(&basicUint8), nullable.NewUint8(nil),
+		nullable.NewUint16(&basicUint16), nullable.NewUint16(nil),
+		nullable.NewUint32(&basicUint32), nullable.NewUint32(nil),
+		nullable.NewUint64(&basicUint64), nullable.NewUint64(nil),
+	}
+
+	for _, target := range targets {
+		assertJSONSchema(t, target)
+	}
+
+	largeInts := []jsonSchemaDescriber{
+		nullable.NewInt(&basicInt), nullable.NewInt64(&basicInt64),
+		nullable.NewUint(&basicUint), nullable.NewUint64(&basicUint64),
+	}
+
+	nullable.SetLargeIntJSON(nullable.LargeIntAsString)
+	defer nullable.SetLargeIntJSON(nullable.LargeIntAsNumber)
+	for _, target := range largeInts {
+		assertJSONSchema(t, target)
+	}
+}
+
+func TestJSONSchemaLargeIntString(t *testing.T) {
+	basicInt := -1
+	var basicInt64 int64 = -1
+	var basicUint uint = 1
+	var basicUint64 uint64 = 1
+
+	targets := []jsonSchemaDescriber{
+		nullable.NewIntString(&basicInt), nullable.NewIntString(nil),
+		nullable.NewInt64String(&basicInt64), nullable.NewInt64String(nil),
+		nullable.NewUintString(&basicUint), nullable.NewUintString(nil),
+		nullable.NewUint64String(&basicUint64), nullable.NewUint64String(nil),
+	}
+
+	for _, target := range targets {
+		assertJSONSchema(t, target)
+	}
+}
+
+func TestJSONSchemaTimeEpoch(t *testing.T) {
+	basicTime := time.Now()
+
+	targets := []jsonSchemaDescriber{
+		nullable.NewTimeUnix(&basicTime), nullable.NewTimeUnix(nil),
+		nullable.NewTimeUnixMilli(&basicTime), nullable.NewTimeUnixMilli(nil),
+		nullable.NewTimeUnixNano(&basicTime), nullable.NewTimeUnixNano(nil),
+	}
+
+	for _, target := range targets {
+		assertJSONSchema(t, target)
+	}
+
+	nullable.SetTimeJSON(nullable.TimeAsUnixMilli)
+	defer nullable.SetTimeJSON(nullable.TimeAsLayout)
+	assertJSONSchema(t, nullable.NewTime(&basicTime))
+}
+
+func TestJSONSchemaBytesEncoding(t *testing.T) {
+	var basicByte byte = 0xff
+	basicBytes := []byte{0x1}
+
+	targets := []jsonSchemaDescriber{
+		nullable.NewByteHex(&basicByte), nullable.NewByteHex(nil),
+		nullable.NewBytesBase64URL(&basicBytes), nullable.NewBytesBase64URL(nil),
+		nullable.NewBytesHex(&basicBytes), nullable.NewBytesHex(nil),
+	}
+
+	for _, target := range targets {
+		assertJSONSchema(t, target)
+	}
+}
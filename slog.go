@@ -0,0 +1,104 @@
+package nullable
+
+import (
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+// RedactedValue replaces values hidden by RedactSecrets
+const RedactedValue = "[REDACTED]"
+
+var defaultSecretKeys = []string{"password", "secret", "token", "apikey", "api_key", "credential", "credentials"}
+
+// nullLogValue is the null marker of LogValue, written as null by slog.JSONHandler
+func nullLogValue() slog.Value {
+	return slog.AnyValue(nil)
+}
+
+// RedactSecrets creates slog.HandlerOptions.ReplaceAttr which hides attributes whose key,
+// or the key of any enclosing group, has one of keys as whole words. Words are split on
+// '_', '-', '.', spaces, and camelCase, and compared case-insensitively, so "token" hides
+// "accessToken" and "auth_token" but not "tokens_count". Without keys, "password", "secret",
+// "token", "apikey", "api_key", "credential", and "credentials" are hidden.
+// NULL stays null, so presence of a secret is still visible:
+//
+//	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{ReplaceAttr: nullable.RedactSecrets()})
+func RedactSecrets(keys ...string) func(groups []string, attr slog.Attr) slog.Attr {
+	if len(keys) == 0 {
+		keys = defaultSecretKeys
+	}
+	split := make([][]string, len(keys))
+	for i, key := range keys {
+		split[i] = splitKeyWords(key)
+	}
+
+	isSecret := func(name string) bool {
+		words := splitKeyWords(name)
+		for _, key := range split {
+			if hasWords(words, key) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(groups []string, attr slog.Attr) slog.Attr {
+		if attr.Value.Kind() == slog.KindAny && attr.Value.Any() == nil {
+			return attr
+		}
+		isRedacted := isSecret(attr.Key)
+		for _, group := range groups {
+			isRedacted = isRedacted || isSecret(group)
+		}
+		if isRedacted {
+			attr.Value = slog.StringValue(RedactedValue)
+		}
+		return attr
+	}
+}
+
+// splitKeyWords splits attribute key into lower case words, like "userAPIKey" into "user", "api", and "key"
+func splitKeyWords(key string) []string {
+	var words []string
+	runes := []rune(key)
+	start := 0
+	flush := func(end int) {
+		if end > start {
+			words = append(words, strings.ToLower(string(runes[start:end])))
+		}
+	}
+
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == '.' || unicode.IsSpace(r):
+			flush(i)
+			start = i + 1
+		case i > start && unicode.IsUpper(r) && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])):
+			flush(i)
+			start = i
+		}
+	}
+	flush(len(runes))
+	return words
+}
+
+// hasWords checks whether words contain key as consecutive words
+func hasWords(words, key []string) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for start := 0; start+len(key) <= len(words); start++ {
+		matched := true
+		for i := range key {
+			if words[start+i] != key[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
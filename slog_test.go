@@ -0,0 +1,60 @@
+package nullable_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Thor-x86/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestLogValueJSONHandler(t *testing.T) {
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, nil))
+	logger.Info("user", "age", nullable.NewUint8Value(30), "nickname", nullable.NewString(nil))
+
+	var record map[string]interface{}
+	if err := json.Unmarshal(output.Bytes(), &record); err != nil {
+		t.Fatalf("Failed to decode log record because: %s", err)
+	}
+	tests.AssertEqual(t, record["age"], float64(30))
+	if nickname, isSet := record["nickname"]; !isSet || nickname != nil {
+		t.Errorf("Expected NULL nickname to be logged as null, got %v", output.String())
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	var output bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&output, &slog.HandlerOptions{ReplaceAttr: nullable.RedactSecrets()}))
+	logger.Info("login",
+		"user", nullable.NewStringValue("thor"),
+		"password", nullable.NewStringValue("mjolnir"),
+		"apiToken", nullable.NewStringValue("asgard"),
+		"clientSecret", nullable.NewString(nil),
+		"tokens_count", nullable.NewInt64Value(5),
+		"secretary_id", nullable.NewInt64Value(7),
+		"USER_API_KEY", nullable.NewStringValue("bifrost"),
+		slog.Group("credentials", "pin", nullable.NewInt64Value(1234)),
+	)
+
+	text := output.String()
+	for _, secret := range []string{"mjolnir", "asgard", "1234", "bifrost"} {
+		if strings.Contains(text, secret) {
+			t.Errorf("Expected %q to be redacted, got %s", secret, text)
+		}
+	}
+	for _, visible := range []string{"user=thor", "password=" + nullable.RedactedValue, "clientSecret=<nil>", "tokens_count=5", "secretary_id=7"} {
+		if !strings.Contains(text, visible) {
+			t.Errorf("Expected %q in log, got %s", visible, text)
+		}
+	}
+
+	output.Reset()
+	logger = slog.New(slog.NewTextHandler(&output, &slog.HandlerOptions{ReplaceAttr: nullable.RedactSecrets("ssn")}))
+	logger.Info("citizen", "ssn", nullable.NewStringValue("078-05-1120"), "password", nullable.NewStringValue("visible"))
+	tests.AssertEqual(t, strings.Contains(output.String(), "078-05-1120"), false)
+	tests.AssertEqual(t, strings.Contains(output.String(), "password=visible"), true)
+}
@@ -1,8 +1,13 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
@@ -28,6 +33,14 @@ func NewString(value *string) String {
 	}
 }
 
+// NewStringValue creates a new valid nullable string from plain value
+func NewStringValue(value string) String {
+	return String{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or string
 func (n String) Get() *string {
 	if !n.isValid {
@@ -46,38 +59,233 @@ func (n *String) Set(value *string) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n String) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n String) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n String) ValueOr(fallback string) string {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or "" when NULL
+func (n String) ValueOrZero() string {
+	return n.ValueOr("")
+}
+
+// MustGet returns current value, panics when NULL
+func (n String) MustGet() string {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL String")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n String) Clone() String {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n String) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return n.realValue
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n String) GoString() string {
+	if !n.isValid {
+		return "nullable.NewString(nil)"
+	}
+	return fmt.Sprintf("nullable.NewStringValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n String) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n String) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.StringValue(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n String) Map(fn func(string) string) String {
+	if !n.isValid {
+		return n
+	}
+	return NewStringValue(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n String) FlatMap(fn func(string) String) String {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n String) Filter(fn func(string) bool) String {
+	if !n.isValid || !fn(n.realValue) {
+		return NewString(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n String) Compare(other String, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n String) Equal(other String) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
 // MarshalJSON converts current value to JSON
 func (n String) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n String) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendJSONString(dst, n.realValue)
+}
+
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *String) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *String) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = ""
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed string
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if text, isFast := unquoteJSONFast(data); isFast {
+		parsed = string(text)
+	} else {
+		var slow string
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+// which UnmarshalText reads back as a valid empty string, not NULL
+func (n String) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text is a valid empty string
+func (n *String) UnmarshalText(text []byte) error {
+	n.isValid = true
+	n.realValue = string(text)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n String) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *String) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n String) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, len(n.realValue))
+	if n.isValid {
+		buffer = append(buffer, n.realValue...)
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *String) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "String")
+	if err != nil {
 		return err
 	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
+
+	parsed := string(payload)
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n String) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *String) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *String) Scan(value interface{}) error {
 	if value == nil {
 		n.realValue, n.isValid = "", false
 		return nil
 	}
+
+	parsed, err := scanString(value, n)
+	if err != nil {
+		return err
+	}
+	n.realValue = parsed
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -88,6 +296,26 @@ func (n String) Value() (driver.Value, error) {
 	return n.realValue, nil
 }
 
+// FromSQLNullString converts sql.NullString to nullable string
+func FromSQLNullString(value sql.NullString) String {
+	if !value.Valid {
+		return NewString(nil)
+	}
+	return NewStringValue(value.String)
+}
+
+// SQLNull converts to sql.NullString
+func (n String) SQLNull() sql.NullString {
+	return sql.NullString{String: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (String) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": []string{"string", "null"},
+	}
+}
+
 // GormDataType gorm common data type
 func (String) GormDataType() string {
 	return "string_null"
@@ -1,6 +1,9 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"fmt"
+	"log/slog"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -94,6 +97,138 @@ func TestJSONString(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewString(nil))
 }
 
+func TestGQLString(t *testing.T) {
+	basicString1 := ""
+	marshalUnmarshalGQL(t, nullable.NewString(&basicString1))
+
+	basicString2 := "This is a test string"
+	marshalUnmarshalGQL(t, nullable.NewString(&basicString2))
+
+	basicString3 := "and This is also a test string that really really long, just in case something fails after somebody enter a really long string like this. You know what? Coding unit test is a lot stressful and spend longer time than making the real code itself. So please show me a little respect of writting this really long string. Thank you!"
+	marshalUnmarshalGQL(t, nullable.NewString(&basicString3))
+
+	basicString4 := "~!@#$%^&*()_+`-=:;\"'/\\"
+	marshalUnmarshalGQL(t, nullable.NewString(&basicString4))
+
+	basicString5 := ""
+	marshalUnmarshalGQL(t, nullable.NewString(&basicString5))
+
+	marshalUnmarshalGQL(t, nullable.NewString(nil))
+}
+
+func TestTextString(t *testing.T) {
+	basicString1 := ""
+	marshalUnmarshalText(t, nullable.NewString(&basicString1))
+
+	basicString2 := "This is a test string"
+	marshalUnmarshalText(t, nullable.NewString(&basicString2))
+
+	basicString3 := "and This is also a test string that really really long, just in case something fails after somebody enter a really long string like this. You know what? Coding unit test is a lot stressful and spend longer time than making the real code itself. So please show me a little respect of writting this really long string. Thank you!"
+	marshalUnmarshalText(t, nullable.NewString(&basicString3))
+
+	basicString4 := "~!@#$%^&*()_+`-=:;\"'/\\"
+	marshalUnmarshalText(t, nullable.NewString(&basicString4))
+
+	basicString5 := ""
+	marshalUnmarshalText(t, nullable.NewString(&basicString5))
+
+	// Empty text is a valid empty string, so NULL becomes valid after the trip
+	nullText, _ := nullable.NewString(nil).MarshalText()
+	tests.AssertEqual(t, nullText, []byte{})
+
+	var emptyNullable nullable.String
+	emptyNullable.UnmarshalText([]byte{})
+	tests.AssertEqual(t, emptyNullable.Get(), "")
+}
+
+func TestBinaryString(t *testing.T) {
+	basicString1 := ""
+	marshalUnmarshalBinary(t, nullable.NewString(&basicString1))
+
+	basicString2 := "This is a test string"
+	marshalUnmarshalBinary(t, nullable.NewString(&basicString2))
+
+	basicString3 := "and This is also a test string that really really long, just in case something fails after somebody enter a really long string like this. You know what? Coding unit test is a lot stressful and spend longer time than making the real code itself. So please show me a little respect of writting this really long string. Thank you!"
+	marshalUnmarshalBinary(t, nullable.NewString(&basicString3))
+
+	basicString4 := "~!@#$%^&*()_+`-=:;\"'/\\"
+	marshalUnmarshalBinary(t, nullable.NewString(&basicString4))
+
+	basicString5 := ""
+	marshalUnmarshalBinary(t, nullable.NewString(&basicString5))
+
+	marshalUnmarshalBinary(t, nullable.NewString(nil))
+}
+
+func TestAccessorString(t *testing.T) {
+	nullableValue := nullable.NewStringValue("abc")
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr("x"), "abc")
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), "abc")
+	tests.AssertEqual(t, nullableValue.MustGet(), "abc")
+	tests.AssertEqual(t, nullableValue.Map(func(v string) string { return v + "d" }).MustGet(), "abcd")
+	tests.AssertEqual(t, nullableValue.FlatMap(func(string) nullable.String { return nullable.NewString(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(string) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(string) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.String]("abc"), nullableValue)
+
+	nullValue := nullable.NewString(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr("x"), "x")
+	tests.AssertEqual(t, nullValue.ValueOrZero(), "")
+	tests.AssertEqual(t, nullValue.Map(func(v string) string { return v + "d" }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(string) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullString(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullString(sql.NullString{String: "abc", Valid: true}).Get(), "abc")
+	tests.AssertEqual(t, nullable.FromSQLNullString(sql.NullString{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewStringValue("abc").SQLNull(), sql.NullString{String: "abc", Valid: true})
+	tests.AssertEqual(t, nullable.NewString(nil).SQLNull(), sql.NullString{})
+}
+
+func TestCompareString(t *testing.T) {
+	low, high := nullable.NewStringValue("loki"), nullable.NewStringValue("thor")
+	null := nullable.NewString(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewStringValue("loki"), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewString(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewStringValue("loki")), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewString(nil)), true)
+}
+
+func TestFormatString(t *testing.T) {
+	value := nullable.NewStringValue("thor")
+	null := nullable.NewString(nil)
+	tests.AssertEqual(t, value.String(), "thor")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `thor|"thor"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "thor")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), `nullable.NewStringValue("thor")`)
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewString(nil)")
+}
+
+func TestLogValueString(t *testing.T) {
+	value := nullable.NewStringValue("thor").LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindString)
+	tests.AssertEqual(t, value.String(), "thor")
+
+	null := nullable.NewString(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestString(t *testing.T) {
 	type TestNullableString struct {
 		ID          uint
@@ -7,7 +7,7 @@ dialects=("postgres" "postgres_simple" "mysql" "mysql-legacy" "mariadb" "sqlite"
 for dialect in "${dialects[@]}" ; do
   if [ "$GORM_DIALECT" = "" ] || [ "$GORM_DIALECT" = "${dialect}" ]
   then
-    GORM_DIALECT=${dialect} go test -coverprofile=profile.out -covermode=atomic $d
+    GORM_DIALECT=${dialect} go test -coverprofile=profile.out -covermode=atomic ./...
     if [ -f profile.out ]; then
         cat profile.out >> coverage.txt
         rm profile.out
@@ -1,8 +1,14 @@
 package nullable
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
 	"time"
 
 	"gorm.io/gorm"
@@ -15,6 +21,9 @@ type Time struct {
 	isValid   bool
 }
 
+// dateLayout is accepted by plain text besides the layouts of SetTimeLayouts, like "2021-09-06" for midnight UTC
+const dateLayout = "2006-01-02"
+
 // NewTime creates a new nullable 64-bit integer
 func NewTime(value *time.Time) Time {
 	if value == nil {
@@ -29,6 +38,14 @@ func NewTime(value *time.Time) Time {
 	}
 }
 
+// NewTimeValue creates a new valid nullable time from plain value
+func NewTimeValue(value time.Time) Time {
+	return Time{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 64-bit integer
 func (n Time) Get() *time.Time {
 	if !n.isValid {
@@ -47,22 +64,328 @@ func (n *Time) Set(value *time.Time) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Time) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Time) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Time) ValueOr(fallback time.Time) time.Time {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or time.Time{} when NULL
+func (n Time) ValueOrZero() time.Time {
+	return n.ValueOr(time.Time{})
+}
+
+// MustGet returns current value, panics when NULL
+func (n Time) MustGet() time.Time {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Time")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Time) Clone() Time {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Time) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return n.realValue.String()
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Time) GoString() string {
+	if !n.isValid {
+		return "nullable.NewTime(nil)"
+	}
+	return fmt.Sprintf("nullable.NewTimeValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Time) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Time) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.TimeValue(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Time) Map(fn func(time.Time) time.Time) Time {
+	if !n.isValid {
+		return n
+	}
+	return NewTimeValue(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Time) FlatMap(fn func(time.Time) Time) Time {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Time) Filter(fn func(time.Time) bool) Time {
+	if !n.isValid || !fn(n.realValue) {
+		return NewTime(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes, instants are compared regardless of location
+func (n Time) Compare(other Time, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return n.realValue.Compare(other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Time) Equal(other Time) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue.Equal(other.realValue))
+}
+
+// MarshalJSON converts current value to JSON, see SetTimeJSON and SetTimeLayouts
 func (n Time) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.marshalJSON(currentTimeJSON().epochUnit())
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection.
+// Time beyond int64 epoch of the unit becomes null, MarshalJSON returns error instead.
+func (n Time) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, currentTimeJSON().epochUnit())
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, see SetTimeJSON and SetTimeLayouts, failures are *DecodeError
 func (n *Time) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data, currentTimeJSON().epochUnit()))
+}
+
+// marshalJSON converts current value to JSON with epoch of given unit, or text when unit is zero
+func (n Time) marshalJSON(unit time.Duration) ([]byte, error) {
+	if n.isValid && unit != 0 {
+		if _, isInRange := toEpoch(n.realValue, unit); !isInRange {
+			return nil, overflowOf(n.realValue, new(int64))
+		}
+	}
+	return n.appendJSON(nil, unit), nil
+}
+
+// appendJSON appends epoch number of given unit, or text of the first layout when unit is zero
+func (n Time) appendJSON(dst []byte, unit time.Duration) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	if unit != 0 {
+		epoch, isInRange := toEpoch(n.realValue, unit)
+		if !isInRange {
+			return append(dst, "null"...)
+		}
+		return strconv.AppendInt(dst, epoch, 10)
+	}
+
+	start := len(dst)
+	dst = append(dst, '"')
+	dst = n.realValue.AppendFormat(dst, currentTimeLayouts()[0])
+	if !isJSONSafe(dst[start+1:]) {
+		// Custom layout may have text which must be escaped
+		return appendJSONString(dst[:start], string(dst[start+1:]))
+	}
+	return append(dst, '"')
+}
+
+// unmarshalJSON reads epoch number of given unit, or text of any layout
+func (n *Time) unmarshalJSON(data []byte, unit time.Duration) error {
+	if isJSONNull(data) {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	if isLenientJSON() {
+		value, err := decodeLenientJSON(data)
+		if err != nil {
+			return err
+		}
+		switch typed := value.(type) {
+		case json.Number:
+			epoch, err := typed.Int64()
+			if err != nil {
+				return fmt.Errorf("lenient JSON: epoch must be an integer, got %s", typed)
+			}
+			if unit == 0 {
+				unit = time.Second
+			}
+			n.isValid = true
+			n.realValue = fromEpoch(epoch, unit)
+			return nil
+		case string:
+			// Configured layouts first, then the common database layouts, the zone is
+			// kept like strict mode
+			parsed, err := parseTimeLayouts(typed, currentTimeLayouts())
+			if err != nil {
+				if parsed, err = parseTimeLayouts(typed, lenientTimeLayouts); err != nil {
+					return err
+				}
+			}
+			n.isValid = true
+			n.realValue = parsed
+			return nil
+		}
+		return scanReason(n.Scan(value))
+	}
+
+	if unit != 0 && data[0] != '"' {
+		epoch, isFast := parseJSONInt(data, 64)
+		if !isFast {
+			var slow int64
+			if err := json.Unmarshal(data, &slow); err != nil {
+				return err
+			}
+			epoch = slow
+		}
+
+		n.isValid = true
+		n.realValue = fromEpoch(epoch, unit)
+		return nil
+	}
+
+	var text string
+	if quoted, isFast := unquoteJSONFast(data); isFast {
+		text = string(quoted)
+	} else {
+		var slow string
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		text = slow
+	}
+
+	parsed, err := parseTimeLayouts(text, currentTimeLayouts())
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text with the first layout of SetTimeLayouts, NULL becomes empty text
+func (n Time) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return n.realValue.AppendFormat(nil, currentTimeLayouts()[0]), nil
+}
+
+// UnmarshalText writes text of any layout of SetTimeLayouts, or date-only text, to this type, empty text becomes NULL
+func (n *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	parsed, err := parseTimeLayouts(string(text), currentTimeLayouts())
+	if err != nil {
+		// Query values and flags often carry only a date, JSON never does
+		var dateErr error
+		if parsed, dateErr = time.Parse(dateLayout, string(text)); dateErr != nil {
+			return err
+		}
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Time) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Time) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, currentTimeJSON().epochUnit())
+}
+
+// unmarshalGQL reads integer as epoch of given unit, other input is handled by readGQL
+func (n *Time) unmarshalGQL(v interface{}, unit time.Duration) error {
+	if unit == 0 {
+		return readGQL(n, v)
+	}
+
+	var epoch int64
+	switch typed := v.(type) {
+	case json.Number:
+		parsed, err := typed.Int64()
+		if err != nil {
+			return err
+		}
+		epoch = parsed
+	case int64:
+		epoch = typed
+	case int:
+		epoch = int64(typed)
+	default:
+		return readGQL(n, v)
+	}
+
+	n.isValid = true
+	n.realValue = fromEpoch(epoch, unit)
+	return nil
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Time) MarshalBinary() ([]byte, error) {
+	if !n.isValid {
+		return newBinary(false, 0), nil
+	}
+
+	encoded, err := n.realValue.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(newBinary(true, len(encoded)), encoded...), nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Time) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Time")
+	if err != nil {
+		return err
+	}
+	if !isValid {
 		n.isValid = false
 		n.realValue = time.Time{}
 		return nil
 	}
 
 	var parsed time.Time
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if err := parsed.UnmarshalBinary(payload); err != nil {
 		return err
 	}
 
@@ -71,6 +394,16 @@ func (n *Time) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// GobEncode implements gob.GobEncoder interface
+func (n Time) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Time) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
 // Scan implements scanner interface
 func (n *Time) Scan(value interface{}) error {
 	if value == nil {
@@ -78,9 +411,9 @@ func (n *Time) Scan(value interface{}) error {
 		return nil
 	}
 
-	var utcTime time.Time
-	if err := convertAssign(&utcTime, value); err != nil {
-		return err
+	utcTime, isTime := value.(time.Time)
+	if !isTime {
+		return newUnsupportedError(value, n)
 	}
 	n.realValue = utcTime.Local()
 
@@ -96,6 +429,24 @@ func (n Time) Value() (driver.Value, error) {
 	return n.realValue.UTC(), nil
 }
 
+// FromSQLNullTime converts sql.NullTime to nullable time
+func FromSQLNullTime(value sql.NullTime) Time {
+	if !value.Valid {
+		return NewTime(nil)
+	}
+	return NewTimeValue(value.Time)
+}
+
+// SQLNull converts to sql.NullTime
+func (n Time) SQLNull() sql.NullTime {
+	return sql.NullTime{Time: n.realValue, Valid: n.isValid}
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Time) JSONSchema() map[string]interface{} {
+	return timeSchema(currentTimeJSON().epochUnit())
+}
+
 // GormDataType gorm common data type
 func (Time) GormDataType() string {
 	return "timestamp_null"
@@ -113,3 +464,230 @@ func (Time) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// lenientTimeLayouts are tried in order after the configured layouts while reading lenient JSON
+var lenientTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	dateLayout,
+}
+
+// parseTimeLayouts tries every layout in order, time without zone is UTC
+func parseTimeLayouts(text string, layouts []string) (time.Time, error) {
+	var firstErr error
+	for _, layout := range layouts {
+		parsed, err := time.Parse(layout, text)
+		if err == nil {
+			return parsed, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return time.Time{}, firstErr
+}
+
+// toEpoch converts time into Unix epoch of given unit, false when it doesn't fit into
+// int64, like nanoseconds after year 2262
+func toEpoch(value time.Time, unit time.Duration) (int64, bool) {
+	perSecond := int64(time.Second / unit)
+	seconds := value.Unix()
+	fraction := int64(value.Nanosecond()) / int64(unit)
+	if seconds > (math.MaxInt64-fraction)/perSecond || seconds < math.MinInt64/perSecond {
+		return 0, false
+	}
+	return seconds*perSecond + fraction, true
+}
+
+// fromEpoch converts Unix epoch of given unit into local time
+func fromEpoch(epoch int64, unit time.Duration) time.Time {
+	perSecond := int64(time.Second / unit)
+	return time.Unix(epoch/perSecond, epoch%perSecond*int64(unit))
+}
+
+// timeSchema describes epoch number of given unit, or text of the first layout when unit is zero
+func timeSchema(unit time.Duration) map[string]interface{} {
+	if unit != 0 {
+		return map[string]interface{}{
+			"type":   []string{"integer", "null"},
+			"format": "int64",
+		}
+	}
+
+	described := map[string]interface{}{
+		"type": []string{"string", "null"},
+	}
+	switch currentTimeLayouts()[0] {
+	case time.RFC3339, time.RFC3339Nano:
+		described["format"] = "date-time"
+	case dateLayout:
+		described["format"] = "date"
+	}
+	return described
+}
+
+// TimeUnix is Time which is always written to JSON as Unix epoch seconds
+type TimeUnix struct {
+	Time
+}
+
+// NewTimeUnix creates a new nullable time which is written to JSON as Unix epoch seconds
+func NewTimeUnix(value *time.Time) TimeUnix {
+	return TimeUnix{NewTime(value)}
+}
+
+// Clone returns a copy
+func (n TimeUnix) Clone() TimeUnix {
+	return TimeUnix{n.Time.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n TimeUnix) GoString() string {
+	return "nullable.TimeUnix{Time: " + n.Time.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Time.Format
+func (n TimeUnix) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON number of Unix epoch seconds
+func (n TimeUnix) MarshalJSON() ([]byte, error) {
+	return n.marshalJSON(time.Second)
+}
+
+// AppendJSON appends JSON number of Unix epoch seconds to dst without reflection
+func (n TimeUnix) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, time.Second)
+}
+
+// UnmarshalJSON writes JSON to this type, both epoch seconds and text are accepted, failures are *DecodeError
+func (n *TimeUnix) UnmarshalJSON(data []byte) error {
+	return newDecodeError(n, data, n.unmarshalJSON(data, time.Second))
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n TimeUnix) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen, integers are epoch seconds
+func (n *TimeUnix) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, time.Second)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (TimeUnix) JSONSchema() map[string]interface{} {
+	return timeSchema(time.Second)
+}
+
+// TimeUnixMilli is Time which is always written to JSON as Unix epoch milliseconds
+type TimeUnixMilli struct {
+	Time
+}
+
+// NewTimeUnixMilli creates a new nullable time which is written to JSON as Unix epoch milliseconds
+func NewTimeUnixMilli(value *time.Time) TimeUnixMilli {
+	return TimeUnixMilli{NewTime(value)}
+}
+
+// Clone returns a copy
+func (n TimeUnixMilli) Clone() TimeUnixMilli {
+	return TimeUnixMilli{n.Time.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n TimeUnixMilli) GoString() string {
+	return "nullable.TimeUnixMilli{Time: " + n.Time.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Time.Format
+func (n TimeUnixMilli) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON number of Unix epoch milliseconds
+func (n TimeUnixMilli) MarshalJSON() ([]byte, error) {
+	return n.marshalJSON(time.Millisecond)
+}
+
+// AppendJSON appends JSON number of Unix epoch milliseconds to dst without reflection
+func (n TimeUnixMilli) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, time.Millisecond)
+}
+
+// UnmarshalJSON writes JSON to this type, both epoch milliseconds and text are accepted, failures are *DecodeError
+func (n *TimeUnixMilli) UnmarshalJSON(data []byte) error {
+	return newDecodeError(n, data, n.unmarshalJSON(data, time.Millisecond))
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n TimeUnixMilli) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen, integers are epoch milliseconds
+func (n *TimeUnixMilli) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, time.Millisecond)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (TimeUnixMilli) JSONSchema() map[string]interface{} {
+	return timeSchema(time.Millisecond)
+}
+
+// TimeUnixNano is Time which is always written to JSON as Unix epoch nanoseconds
+type TimeUnixNano struct {
+	Time
+}
+
+// NewTimeUnixNano creates a new nullable time which is written to JSON as Unix epoch nanoseconds
+func NewTimeUnixNano(value *time.Time) TimeUnixNano {
+	return TimeUnixNano{NewTime(value)}
+}
+
+// Clone returns a copy
+func (n TimeUnixNano) Clone() TimeUnixNano {
+	return TimeUnixNano{n.Time.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n TimeUnixNano) GoString() string {
+	return "nullable.TimeUnixNano{Time: " + n.Time.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Time.Format
+func (n TimeUnixNano) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON number of Unix epoch nanoseconds
+func (n TimeUnixNano) MarshalJSON() ([]byte, error) {
+	return n.marshalJSON(time.Nanosecond)
+}
+
+// AppendJSON appends JSON number of Unix epoch nanoseconds to dst without reflection
+func (n TimeUnixNano) AppendJSON(dst []byte) []byte {
+	return n.appendJSON(dst, time.Nanosecond)
+}
+
+// UnmarshalJSON writes JSON to this type, both epoch nanoseconds and text are accepted, failures are *DecodeError
+func (n *TimeUnixNano) UnmarshalJSON(data []byte) error {
+	return newDecodeError(n, data, n.unmarshalJSON(data, time.Nanosecond))
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n TimeUnixNano) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen, integers are epoch nanoseconds
+func (n *TimeUnixNano) UnmarshalGQL(v interface{}) error {
+	return n.unmarshalGQL(v, time.Nanosecond)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (TimeUnixNano) JSONSchema() map[string]interface{} {
+	return timeSchema(time.Nanosecond)
+}
@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -19,6 +24,13 @@ func TestScanTime(t *testing.T) {
 	nullableTime.Scan(basicTime.UTC())
 	tests.AssertEqual(t, nullableTime.Get().Unix(), basicTime.Unix())
 
+	// Epoch and text are only for lenient JSON, an integer column isn't a time
+	for _, invalid := range []interface{}{int64(1630922400), "2021-09-06 10:00:00"} {
+		if err := nullableTime.Scan(invalid); err == nil {
+			t.Errorf("Expected error while scanning %v", invalid)
+		}
+	}
+
 	nullableTime.Scan(nil)
 	tests.AssertEqual(t, nullableTime.Get(), nil)
 }
@@ -51,6 +63,259 @@ func TestJSONTime(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewTime(nil))
 }
 
+func TestGQLTime(t *testing.T) {
+	basicTime := time.Now()
+	marshalUnmarshalGQL(t, nullable.NewTime(&basicTime))
+
+	marshalUnmarshalGQL(t, nullable.NewTime(nil))
+}
+
+func TestTextTime(t *testing.T) {
+	basicTime := time.Now()
+	marshalUnmarshalText(t, nullable.NewTime(&basicTime))
+
+	marshalUnmarshalText(t, nullable.NewTime(nil))
+}
+
+func TestDateOnlyTime(t *testing.T) {
+	midnight := time.Date(2021, 9, 6, 0, 0, 0, 0, time.UTC)
+
+	// Date-only is for plain text like query values, JSON stays RFC 3339
+	var fromJSON nullable.Time
+	if err := json.Unmarshal([]byte(`"2021-09-06"`), &fromJSON); err == nil {
+		t.Error("Expected error while unmarshalling date-only JSON")
+	}
+
+	var fromText nullable.Time
+	if err := fromText.UnmarshalText([]byte("2021-09-06")); err != nil {
+		t.Fatalf("Failed to unmarshal date-only text because: %s", err)
+	}
+	tests.AssertEqual(t, fromText.Get().Equal(midnight), true)
+
+	if err := fromText.UnmarshalText([]byte("06/09/2021")); err == nil {
+		t.Error("Expected error while unmarshalling unknown layout")
+	}
+}
+
+func TestEpochTime(t *testing.T) {
+	basicTime := time.Date(2021, 9, 6, 10, 0, 0, 123456789, time.UTC)
+
+	serialized, _ := json.Marshal(nullable.NewTimeUnix(&basicTime))
+	tests.AssertEqual(t, string(serialized), "1630922400")
+
+	serialized, _ = json.Marshal(nullable.NewTimeUnixMilli(&basicTime))
+	tests.AssertEqual(t, string(serialized), "1630922400123")
+
+	serialized, _ = json.Marshal(nullable.NewTimeUnixNano(&basicTime))
+	tests.AssertEqual(t, string(serialized), "1630922400123456789")
+
+	serialized, _ = json.Marshal(nullable.NewTimeUnixMilli(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	var fromMilli nullable.TimeUnixMilli
+	if err := json.Unmarshal([]byte("1630922400123"), &fromMilli); err != nil {
+		t.Fatalf("Failed to unmarshal epoch milliseconds because: %s", err)
+	}
+	tests.AssertEqual(t, fromMilli.Get().Equal(basicTime.Truncate(time.Millisecond)), true)
+
+	var fromText nullable.TimeUnix
+	if err := json.Unmarshal([]byte(`"2021-09-06T10:00:00Z"`), &fromText); err != nil {
+		t.Fatalf("Failed to unmarshal text into epoch time because: %s", err)
+	}
+	tests.AssertEqual(t, fromText.Get().Unix(), int64(1630922400))
+
+	var beforeEpoch nullable.TimeUnixMilli
+	json.Unmarshal([]byte("-1500"), &beforeEpoch)
+	tests.AssertEqual(t, beforeEpoch.Get().Equal(time.Unix(-2, 500000000)), true)
+	serialized, _ = json.Marshal(beforeEpoch)
+	tests.AssertEqual(t, string(serialized), "-1500")
+
+	var fromGQL nullable.TimeUnixMilli
+	fromGQL.UnmarshalGQL(json.Number("1630922400123"))
+	tests.AssertEqual(t, fromGQL.Get().Equal(basicTime.Truncate(time.Millisecond)), true)
+}
+
+func TestEpochOverflowTime(t *testing.T) {
+	farFuture := time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := json.Marshal(nullable.NewTimeUnixNano(&farFuture)); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while marshalling year 2300 as epoch nanoseconds, got %v", err)
+	}
+	tests.AssertEqual(t, string(nullable.NewTimeUnixNano(&farFuture).AppendJSON(nil)), "null")
+
+	serialized, err := json.Marshal(nullable.NewTimeUnixMilli(&farFuture))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "10413792000000")
+}
+
+func TestLenientZoneTime(t *testing.T) {
+	input := []byte(`"2021-09-06T17:00:00+07:00"`)
+
+	var strict nullable.Time
+	if err := json.Unmarshal(input, &strict); err != nil {
+		t.Fatalf("Failed to unmarshal strict JSON because: %s", err)
+	}
+
+	nullable.SetJSONDecoding(nullable.LenientJSON)
+	var lenient nullable.Time
+	err := json.Unmarshal(input, &lenient)
+	nullable.SetJSONDecoding(nullable.StrictJSON)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal lenient JSON because: %s", err)
+	}
+
+	_, strictOffset := strict.Get().Zone()
+	_, lenientOffset := lenient.Get().Zone()
+	tests.AssertEqual(t, lenientOffset, strictOffset)
+	tests.AssertEqual(t, lenient.Get().Equal(*strict.Get()), true)
+}
+
+func TestTimeJSONMode(t *testing.T) {
+	basicTime := time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+
+	nullable.SetTimeJSON(nullable.TimeAsUnixMilli)
+	defer nullable.SetTimeJSON(nullable.TimeAsLayout)
+
+	serialized, _ := json.Marshal(nullable.NewTime(&basicTime))
+	tests.AssertEqual(t, string(serialized), "1630922400000")
+
+	var fromEpoch nullable.Time
+	if err := json.Unmarshal([]byte("1630922400000"), &fromEpoch); err != nil {
+		t.Fatalf("Failed to unmarshal epoch milliseconds because: %s", err)
+	}
+	tests.AssertEqual(t, fromEpoch.Get().Equal(basicTime), true)
+
+	var fromText nullable.Time
+	if err := json.Unmarshal([]byte(`"2021-09-06T10:00:00Z"`), &fromText); err != nil {
+		t.Fatalf("Failed to unmarshal text in epoch mode because: %s", err)
+	}
+	tests.AssertEqual(t, fromText.Get().Equal(basicTime), true)
+}
+
+func TestTimeLayouts(t *testing.T) {
+	basicTime := time.Date(2021, 9, 6, 10, 0, 0, 0, time.UTC)
+
+	nullable.SetTimeLayouts("2006-01-02 15:04:05", "02/01/2006")
+	defer nullable.SetTimeLayouts()
+
+	serialized, _ := json.Marshal(nullable.NewTime(&basicTime))
+	tests.AssertEqual(t, string(serialized), `"2021-09-06 10:00:00"`)
+
+	var fromFirst nullable.Time
+	if err := json.Unmarshal(serialized, &fromFirst); err != nil {
+		t.Fatalf("Failed to unmarshal first layout because: %s", err)
+	}
+	tests.AssertEqual(t, fromFirst.Get().Equal(basicTime), true)
+
+	var fromSecond nullable.Time
+	if err := json.Unmarshal([]byte(`"06/09/2021"`), &fromSecond); err != nil {
+		t.Fatalf("Failed to unmarshal second layout because: %s", err)
+	}
+	tests.AssertEqual(t, fromSecond.Get().Equal(basicTime.Truncate(24*time.Hour)), true)
+
+	if err := fromSecond.UnmarshalJSON([]byte(`"2021-09-06T10:00:00Z"`)); err == nil {
+		t.Error("Expected error while unmarshalling unregistered layout")
+	}
+
+	text, _ := nullable.NewTime(&basicTime).MarshalText()
+	tests.AssertEqual(t, string(text), "2021-09-06 10:00:00")
+
+	var fromText nullable.Time
+	if err := fromText.UnmarshalText([]byte("06/09/2021")); err != nil {
+		t.Fatalf("Failed to unmarshal text of second layout because: %s", err)
+	}
+	tests.AssertEqual(t, fromText.Get().Equal(basicTime.Truncate(24*time.Hour)), true)
+
+	nullable.SetJSONDecoding(nullable.LenientJSON)
+	var fromLenient nullable.Time
+	err := json.Unmarshal([]byte(`"06/09/2021"`), &fromLenient)
+	nullable.SetJSONDecoding(nullable.StrictJSON)
+	if err != nil {
+		t.Fatalf("Failed to unmarshal lenient second layout because: %s", err)
+	}
+	tests.AssertEqual(t, fromLenient.Get().Equal(basicTime.Truncate(24*time.Hour)), true)
+
+	nullable.SetTimeLayouts()
+	serialized, _ = json.Marshal(nullable.NewTime(&basicTime))
+	tests.AssertEqual(t, string(serialized), `"2021-09-06T10:00:00Z"`)
+}
+
+func TestBinaryTime(t *testing.T) {
+	basicTime := time.Now()
+	marshalUnmarshalBinary(t, nullable.NewTime(&basicTime))
+
+	marshalUnmarshalBinary(t, nullable.NewTime(nil))
+}
+
+func TestAccessorTime(t *testing.T) {
+	nullableValue := nullable.NewTimeValue(time.Unix(1630922400, 0))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(time.Unix(0, 0)), time.Unix(1630922400, 0))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), time.Unix(1630922400, 0))
+	tests.AssertEqual(t, nullableValue.MustGet(), time.Unix(1630922400, 0))
+	tests.AssertEqual(t, nullableValue.Map(func(v time.Time) time.Time { return v.Add(time.Hour) }).MustGet(), time.Unix(1630926000, 0))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(time.Time) nullable.Time { return nullable.NewTime(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(time.Time) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(time.Time) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Time](time.Unix(1630922400, 0)), nullableValue)
+
+	nullValue := nullable.NewTime(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(time.Unix(0, 0)), time.Unix(0, 0))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), time.Time{})
+	tests.AssertEqual(t, nullValue.Map(func(v time.Time) time.Time { return v.Add(time.Hour) }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(time.Time) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullTime(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullTime(sql.NullTime{Time: time.Unix(1630922400, 0), Valid: true}).Get(), time.Unix(1630922400, 0))
+	tests.AssertEqual(t, nullable.FromSQLNullTime(sql.NullTime{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewTimeValue(time.Unix(1630922400, 0)).SQLNull(), sql.NullTime{Time: time.Unix(1630922400, 0), Valid: true})
+	tests.AssertEqual(t, nullable.NewTime(nil).SQLNull(), sql.NullTime{})
+}
+
+func TestCompareTime(t *testing.T) {
+	low, high := nullable.NewTimeValue(time.Unix(1630922400, 0)), nullable.NewTimeValue(time.Unix(1630926000, 0))
+	null := nullable.NewTime(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewTimeValue(time.Unix(1630922400, 0)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewTime(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewTimeValue(time.Unix(1630922400, 0))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewTime(nil)), true)
+}
+
+func TestFormatTime(t *testing.T) {
+	value := nullable.NewTimeValue(time.Date(2021, time.September, 6, 10, 0, 0, 0, time.UTC))
+	null := nullable.NewTime(nil)
+	tests.AssertEqual(t, value.String(), "2021-09-06 10:00:00 +0000 UTC")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `2021-09-06 10:00:00 +0000 UTC|"2021-09-06 10:00:00 +0000 UTC"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "2021-09-06 10:00:00 +0000 UTC")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewTimeValue(time.Date(2021, time.September, 6, 10, 0, 0, 0, time.UTC))")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewTime(nil)")
+}
+
+func TestLogValueTime(t *testing.T) {
+	value := nullable.NewTimeValue(time.Unix(1630922400, 0)).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindTime)
+	tests.AssertEqual(t, value.Time().Unix(), int64(1630922400))
+
+	null := nullable.NewTime(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestTime(t *testing.T) {
 	type TestNullableTime struct {
 		UserID     uint `gorm:"primaryKey"`
@@ -1,9 +1,16 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"strconv"
 
 	"gorm.io/gorm/clause"
@@ -32,6 +39,14 @@ func NewUint(value *uint) Uint {
 	}
 }
 
+// NewUintValue creates a new valid nullable unsigned integer from plain value
+func NewUintValue(value uint) Uint {
+	return Uint{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or unsigned integer
 func (n Uint) Get() *uint {
 	if !n.isValid {
@@ -50,23 +65,216 @@ func (n *Uint) Set(value *uint) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Uint) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Uint) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Uint) ValueOr(fallback uint) uint {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Uint) ValueOrZero() uint {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Uint) MustGet() uint {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Uint")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Uint) Clone() Uint {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Uint) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Uint) GoString() string {
+	if !n.isValid {
+		return "nullable.NewUint(nil)"
+	}
+	return fmt.Sprintf("nullable.NewUintValue(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Uint) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Uint) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Uint64Value(uint64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Uint) Map(fn func(uint) uint) Uint {
+	if !n.isValid {
+		return n
+	}
+	return NewUintValue(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Uint) FlatMap(fn func(uint) Uint) Uint {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Uint) Filter(fn func(uint) bool) Uint {
+	if !n.isValid || !fn(n.realValue) {
+		return NewUint(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Uint) Compare(other Uint, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Uint) Equal(other Uint) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Uint) Add(other Uint) Uint {
+	if !n.isValid || !other.isValid {
+		return NewUint(nil)
+	}
+	return NewUintValue(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Uint) Sub(other Uint) Uint {
+	if !n.isValid || !other.isValid {
+		return NewUint(nil)
+	}
+	return NewUintValue(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Uint) Mul(other Uint) Uint {
+	if !n.isValid || !other.isValid {
+		return NewUint(nil)
+	}
+	return NewUintValue(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Uint) Div(other Uint) (Uint, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewUint(nil), divisionByZero()
+	}
+	return NewUintValue(n.realValue / other.realValue), nil
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Uint) AddChecked(other Uint) (Uint, error) {
+	return n.checked("+", other, addUnsigned[uint])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Uint) SubChecked(other Uint) (Uint, error) {
+	return n.checked("-", other, subUnsigned[uint])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Uint) MulChecked(other Uint) (Uint, error) {
+	return n.checked("*", other, mulUnsigned[uint])
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Uint) checked(operator string, other Uint, fn func(a, b uint) (uint, bool)) (Uint, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewUint(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Uint))
+	}
+	return NewUintValue(result), nil
+}
+
+// MarshalJSON converts current value to JSON, see SetLargeIntJSON
 func (n Uint) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Uint) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeUint(dst, uint64(n.realValue), isLargeIntAsString())
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, both number and quoted number are accepted, failures are *DecodeError
 func (n *Uint) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Uint) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
+	digits, isQuoted := unquoteLargeInt(data)
 	var parsed uint
-	if err := json.Unmarshal(data, &parsed); err != nil {
-		return err
+	if fast, isFast := parseJSONUint(digits, strconv.IntSize); isFast {
+		parsed = uint(fast)
+	} else if isQuoted {
+		quoted, err := parseQuotedUint(digits, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+		parsed = uint(quoted)
+	} else {
+		var slow uint
+		if err := json.Unmarshal(digits, &slow); err != nil {
+			return err
+		}
+		parsed = slow
 	}
 
 	n.isValid = true
@@ -74,24 +282,92 @@ func (n *Uint) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements scanner interface
-func (n *Uint) Scan(value interface{}) error {
-	if value == nil {
-		n.realValue, n.isValid = 0, false
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Uint) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Uint) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
 		return nil
 	}
 
-	var scanned string
-	if err := convertAssign(&scanned, value); err != nil {
+	parsed, err := strconv.ParseUint(string(text), 10, strconv.IntSize)
+	if err != nil {
 		return err
 	}
 
-	radix := 10
-	if len(scanned) == 64 {
-		radix = 2
+	n.isValid = true
+	n.realValue = uint(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Uint) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Uint) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Uint) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendUvarint(buffer, uint64(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Uint) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Uint")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
 	}
 
-	parsed, err := strconv.ParseUint(scanned, radix, 64)
+	decoded, err := readUvarint(payload, strconv.IntSize, "Uint")
+	if err != nil {
+		return err
+	}
+	parsed := uint(decoded)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder interface
+func (n Uint) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Uint) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// Scan implements scanner interface
+func (n *Uint) Scan(value interface{}) error {
+	if value == nil {
+		n.realValue, n.isValid = 0, false
+		return nil
+	}
+
+	parsed, err := scanUint(value, 64, n)
 	if err != nil {
 		return err
 	}
@@ -109,6 +385,26 @@ func (n Uint) Value() (driver.Value, error) {
 	return strconv.FormatUint(uint64(n.realValue), 10), nil
 }
 
+// FromSQLNullUint converts sql.NullInt64 to nullable unsigned integer, fails with *OverflowError when out of range
+func FromSQLNullUint(value sql.NullInt64) (Uint, error) {
+	if !value.Valid {
+		return NewUint(nil), nil
+	}
+	v := value.Int64
+	if v < 0 {
+		return NewUint(nil), overflowOf(v, new(Uint))
+	}
+	return NewUintValue(uint(v)), nil
+}
+
+// SQLNull converts to sql.NullInt64, fails with *OverflowError when current value exceeds int64
+func (n Uint) SQLNull() (sql.NullInt64, error) {
+	if n.isValid && uint64(n.realValue) > math.MaxInt64 {
+		return sql.NullInt64{}, overflowOf(n.realValue, new(int64))
+	}
+	return sql.NullInt64{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	switch db.Dialector.Name() {
@@ -135,6 +431,15 @@ func (n Uint) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	return clause.Expr{}
 }
 
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Uint) JSONSchema() map[string]interface{} {
+	return largeIntSchema(map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int64",
+		"minimum": 0,
+	}, isLargeIntAsString())
+}
+
 // GormDataType gorm common data type
 func (Uint) GormDataType() string {
 	return "uint_null"
@@ -150,3 +455,51 @@ func (Uint) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// UintString is Uint which is always written to JSON as string, like "9007199254740993"
+type UintString struct {
+	Uint
+}
+
+// NewUintString creates a new nullable unsigned integer which is written to JSON as string
+func NewUintString(value *uint) UintString {
+	return UintString{NewUint(value)}
+}
+
+// Clone returns a copy
+func (n UintString) Clone() UintString {
+	return UintString{n.Uint.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n UintString) GoString() string {
+	return "nullable.UintString{Uint: " + n.Uint.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Uint.Format
+func (n UintString) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string
+func (n UintString) MarshalJSON() ([]byte, error) {
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON string of current value to dst without reflection
+func (n UintString) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeUint(dst, uint64(n.realValue), true)
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n UintString) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (n UintString) JSONSchema() map[string]interface{} {
+	return largeIntSchema(n.Uint.JSONSchema(), true)
+}
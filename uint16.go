@@ -1,9 +1,16 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"strconv"
 
 	"gorm.io/gorm"
@@ -31,6 +38,14 @@ func NewUint16(value *uint16) Uint16 {
 	}
 }
 
+// NewUint16Value creates a new valid nullable 16-bit unsigned integer from plain value
+func NewUint16Value(value uint16) Uint16 {
+	return Uint16{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 16-bit unsigned integer
 func (n Uint16) Get() *uint16 {
 	if !n.isValid {
@@ -49,23 +64,209 @@ func (n *Uint16) Set(value *uint16) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Uint16) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Uint16) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Uint16) ValueOr(fallback uint16) uint16 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Uint16) ValueOrZero() uint16 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Uint16) MustGet() uint16 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Uint16")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Uint16) Clone() Uint16 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Uint16) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Uint16) GoString() string {
+	if !n.isValid {
+		return "nullable.NewUint16(nil)"
+	}
+	return fmt.Sprintf("nullable.NewUint16Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Uint16) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Uint16) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Uint64Value(uint64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Uint16) Map(fn func(uint16) uint16) Uint16 {
+	if !n.isValid {
+		return n
+	}
+	return NewUint16Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Uint16) FlatMap(fn func(uint16) Uint16) Uint16 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Uint16) Filter(fn func(uint16) bool) Uint16 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewUint16(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Uint16) Compare(other Uint16, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Uint16) Equal(other Uint16) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Uint16) Add(other Uint16) Uint16 {
+	if !n.isValid || !other.isValid {
+		return NewUint16(nil)
+	}
+	return NewUint16Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Uint16) Sub(other Uint16) Uint16 {
+	if !n.isValid || !other.isValid {
+		return NewUint16(nil)
+	}
+	return NewUint16Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Uint16) Mul(other Uint16) Uint16 {
+	if !n.isValid || !other.isValid {
+		return NewUint16(nil)
+	}
+	return NewUint16Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Uint16) Div(other Uint16) (Uint16, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint16(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewUint16(nil), divisionByZero()
+	}
+	return NewUint16Value(n.realValue / other.realValue), nil
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Uint16) AddChecked(other Uint16) (Uint16, error) {
+	return n.checked("+", other, addUnsigned[uint16])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Uint16) SubChecked(other Uint16) (Uint16, error) {
+	return n.checked("-", other, subUnsigned[uint16])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Uint16) MulChecked(other Uint16) (Uint16, error) {
+	return n.checked("*", other, mulUnsigned[uint16])
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Uint16) checked(operator string, other Uint16, fn func(a, b uint16) (uint16, bool)) (Uint16, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint16(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewUint16(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Uint16))
+	}
+	return NewUint16Value(result), nil
+}
+
 // MarshalJSON converts current value to JSON
 func (n Uint16) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Uint16) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendUint(dst, uint64(n.realValue), 10)
+}
+
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Uint16) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Uint16) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed uint16
-	if err := json.Unmarshal(data, &parsed); err != nil {
-		return err
+	if fast, isFast := parseJSONUint(data, 16); isFast {
+		parsed = uint16(fast)
+	} else {
+		var slow uint16
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
 	}
 
 	n.isValid = true
@@ -73,24 +274,92 @@ func (n *Uint16) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements scanner interface
-func (n *Uint16) Scan(value interface{}) error {
-	if value == nil {
-		n.realValue, n.isValid = 0, false
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Uint16) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Uint16) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 16)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = uint16(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Uint16) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Uint16) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Uint16) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendUvarint(buffer, uint64(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Uint16) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Uint16")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
 		return nil
 	}
 
-	var scanned string
-	if err := convertAssign(&scanned, value); err != nil {
+	decoded, err := readUvarint(payload, 16, "Uint16")
+	if err != nil {
 		return err
 	}
+	parsed := uint16(decoded)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder interface
+func (n Uint16) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Uint16) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
 
-	radix := 10
-	if len(scanned) == 16 {
-		radix = 2
+// Scan implements scanner interface
+func (n *Uint16) Scan(value interface{}) error {
+	if value == nil {
+		n.realValue, n.isValid = 0, false
+		return nil
 	}
 
-	parsed, err := strconv.ParseUint(scanned, radix, 16)
+	parsed, err := scanUint(value, 16, n)
 	if err != nil {
 		return err
 	}
@@ -108,6 +377,35 @@ func (n Uint16) Value() (driver.Value, error) {
 	return strconv.FormatUint(uint64(n.realValue), 10), nil
 }
 
+// FromSQLNullUint16 converts sql.NullInt32 to nullable 16-bit unsigned integer, fails with *OverflowError when out of range
+func FromSQLNullUint16(value sql.NullInt32) (Uint16, error) {
+	if !value.Valid {
+		return NewUint16(nil), nil
+	}
+	v := value.Int32
+	if v < 0 || int32(uint16(v)) != v {
+		return NewUint16(nil), overflowOf(v, new(Uint16))
+	}
+	return NewUint16Value(uint16(v)), nil
+}
+
+// FromSQLNullInt64ToUint16 converts sql.NullInt64 to nullable 16-bit unsigned integer, fails with *OverflowError when out of range
+func FromSQLNullInt64ToUint16(value sql.NullInt64) (Uint16, error) {
+	if !value.Valid {
+		return NewUint16(nil), nil
+	}
+	v := value.Int64
+	if v < 0 || int64(uint16(v)) != v {
+		return NewUint16(nil), overflowOf(v, new(Uint16))
+	}
+	return NewUint16Value(uint16(v)), nil
+}
+
+// SQLNull converts to sql.NullInt32
+func (n Uint16) SQLNull() sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(n.realValue), Valid: n.isValid}
+}
+
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint16) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	switch db.Dialector.Name() {
@@ -134,6 +432,16 @@ func (n Uint16) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	return clause.Expr{}
 }
 
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Uint16) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int32",
+		"minimum": 0,
+		"maximum": math.MaxUint16,
+	}
+}
+
 // GormDataType gorm common data type
 func (Uint16) GormDataType() string {
 	return "uint16_null"
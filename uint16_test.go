@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -72,6 +77,175 @@ func TestJSONUint16(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint16(nil))
 }
 
+func TestGQLUint16(t *testing.T) {
+	var basicInt1 uint16 = 37
+	marshalUnmarshalGQL(t, nullable.NewUint16(&basicInt1))
+
+	var basicInt2 uint16 = 1234
+	marshalUnmarshalGQL(t, nullable.NewUint16(&basicInt2))
+
+	marshalUnmarshalGQL(t, nullable.NewUint16(nil))
+}
+
+func TestTextUint16(t *testing.T) {
+	var basicInt1 uint16 = 37
+	marshalUnmarshalText(t, nullable.NewUint16(&basicInt1))
+
+	var basicInt2 uint16 = 1234
+	marshalUnmarshalText(t, nullable.NewUint16(&basicInt2))
+
+	marshalUnmarshalText(t, nullable.NewUint16(nil))
+}
+
+func TestBinaryUint16(t *testing.T) {
+	var basicInt1 uint16 = 37
+	marshalUnmarshalBinary(t, nullable.NewUint16(&basicInt1))
+
+	var basicInt2 uint16 = 1234
+	marshalUnmarshalBinary(t, nullable.NewUint16(&basicInt2))
+
+	marshalUnmarshalBinary(t, nullable.NewUint16(nil))
+}
+
+func TestAccessorUint16(t *testing.T) {
+	nullableValue := nullable.NewUint16Value(uint16(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(uint16(1)), uint16(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), uint16(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), uint16(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v uint16) uint16 { return v + 1 }).MustGet(), uint16(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(uint16) nullable.Uint16 { return nullable.NewUint16(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint16) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint16) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Uint16](uint16(37)), nullableValue)
+
+	nullValue := nullable.NewUint16(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(uint16(1)), uint16(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), uint16(0))
+	tests.AssertEqual(t, nullValue.Map(func(v uint16) uint16 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(uint16) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullUint16(t *testing.T) {
+	converted, err := nullable.FromSQLNullUint16(sql.NullInt32{Int32: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt32 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullUint16(sql.NullInt32{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewUint16Value(37).SQLNull(), sql.NullInt32{Int32: 37, Valid: true})
+	tests.AssertEqual(t, nullable.NewUint16(nil).SQLNull(), sql.NullInt32{})
+
+	if _, err := nullable.FromSQLNullUint16(sql.NullInt32{Int32: 65536, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting 65536 to Uint16, got %v", err)
+	}
+}
+
+func TestSQLNullInt64ToUint16(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt64ToUint16(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullInt64ToUint16(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	for _, outOfRange := range []int64{65536, -1} {
+		if _, err := nullable.FromSQLNullInt64ToUint16(sql.NullInt64{Int64: outOfRange, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while converting %d to Uint16, got %v", outOfRange, err)
+		}
+	}
+}
+
+func TestArithmeticUint16(t *testing.T) {
+	a, b := nullable.NewUint16Value(7), nullable.NewUint16Value(2)
+	null := nullable.NewUint16(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), uint16(9))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), uint16(5))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), uint16(14))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), uint16(3))
+
+	max, one := nullable.NewUint16Value(math.MaxUint16), nullable.NewUint16Value(1)
+	tests.AssertEqual(t, max.Add(one).MustGet(), uint16(0))
+	overflows := []func() (nullable.Uint16, error){
+		func() (nullable.Uint16, error) { return max.AddChecked(one) },
+		func() (nullable.Uint16, error) { return b.SubChecked(a) },
+		func() (nullable.Uint16, error) { return max.MulChecked(b) },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	product, err := a.MulChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, product.MustGet(), uint16(14))
+
+	quotient, err = a.Div(nullable.NewUint16Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewUint16Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareUint16(t *testing.T) {
+	low, high := nullable.NewUint16Value(uint16(3)), nullable.NewUint16Value(uint16(37))
+	null := nullable.NewUint16(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewUint16Value(uint16(3)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewUint16(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewUint16Value(uint16(3))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewUint16(nil)), true)
+}
+
+func TestFormatUint16(t *testing.T) {
+	value := nullable.NewUint16Value(42)
+	null := nullable.NewUint16(nil)
+	tests.AssertEqual(t, value.String(), "42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `42|"42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewUint16Value(0x2a)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewUint16(nil)")
+}
+
+func TestLogValueUint16(t *testing.T) {
+	value := nullable.NewUint16Value(42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindUint64)
+	tests.AssertEqual(t, value.Uint64(), uint64(42))
+
+	null := nullable.NewUint16(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestUint16(t *testing.T) {
 	type TestNullableUint16 struct {
 		ID    uint16
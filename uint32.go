@@ -1,9 +1,16 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"strconv"
 
 	"gorm.io/gorm"
@@ -31,6 +38,14 @@ func NewUint32(value *uint32) Uint32 {
 	}
 }
 
+// NewUint32Value creates a new valid nullable 32-bit unsigned integer from plain value
+func NewUint32Value(value uint32) Uint32 {
+	return Uint32{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 32-bit unsigned integer
 func (n Uint32) Get() *uint32 {
 	if !n.isValid {
@@ -49,23 +64,209 @@ func (n *Uint32) Set(value *uint32) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Uint32) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Uint32) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Uint32) ValueOr(fallback uint32) uint32 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Uint32) ValueOrZero() uint32 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Uint32) MustGet() uint32 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Uint32")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Uint32) Clone() Uint32 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Uint32) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Uint32) GoString() string {
+	if !n.isValid {
+		return "nullable.NewUint32(nil)"
+	}
+	return fmt.Sprintf("nullable.NewUint32Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Uint32) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Uint32) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Uint64Value(uint64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Uint32) Map(fn func(uint32) uint32) Uint32 {
+	if !n.isValid {
+		return n
+	}
+	return NewUint32Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Uint32) FlatMap(fn func(uint32) Uint32) Uint32 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Uint32) Filter(fn func(uint32) bool) Uint32 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewUint32(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Uint32) Compare(other Uint32, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Uint32) Equal(other Uint32) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Uint32) Add(other Uint32) Uint32 {
+	if !n.isValid || !other.isValid {
+		return NewUint32(nil)
+	}
+	return NewUint32Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Uint32) Sub(other Uint32) Uint32 {
+	if !n.isValid || !other.isValid {
+		return NewUint32(nil)
+	}
+	return NewUint32Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Uint32) Mul(other Uint32) Uint32 {
+	if !n.isValid || !other.isValid {
+		return NewUint32(nil)
+	}
+	return NewUint32Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Uint32) Div(other Uint32) (Uint32, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint32(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewUint32(nil), divisionByZero()
+	}
+	return NewUint32Value(n.realValue / other.realValue), nil
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Uint32) AddChecked(other Uint32) (Uint32, error) {
+	return n.checked("+", other, addUnsigned[uint32])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Uint32) SubChecked(other Uint32) (Uint32, error) {
+	return n.checked("-", other, subUnsigned[uint32])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Uint32) MulChecked(other Uint32) (Uint32, error) {
+	return n.checked("*", other, mulUnsigned[uint32])
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Uint32) checked(operator string, other Uint32, fn func(a, b uint32) (uint32, bool)) (Uint32, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint32(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewUint32(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Uint32))
+	}
+	return NewUint32Value(result), nil
+}
+
 // MarshalJSON converts current value to JSON
 func (n Uint32) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Uint32) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendUint(dst, uint64(n.realValue), 10)
+}
+
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Uint32) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Uint32) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed uint32
-	if err := json.Unmarshal(data, &parsed); err != nil {
-		return err
+	if fast, isFast := parseJSONUint(data, 32); isFast {
+		parsed = uint32(fast)
+	} else {
+		var slow uint32
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
 	}
 
 	n.isValid = true
@@ -73,24 +274,92 @@ func (n *Uint32) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements scanner interface
-func (n *Uint32) Scan(value interface{}) error {
-	if value == nil {
-		n.realValue, n.isValid = 0, false
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Uint32) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Uint32) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = uint32(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Uint32) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Uint32) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Uint32) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendUvarint(buffer, uint64(n.realValue))
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Uint32) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Uint32")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
 		return nil
 	}
 
-	var scanned string
-	if err := convertAssign(&scanned, value); err != nil {
+	decoded, err := readUvarint(payload, 32, "Uint32")
+	if err != nil {
 		return err
 	}
+	parsed := uint32(decoded)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
 
-	radix := 10
-	if len(scanned) == 32 {
-		radix = 2
+// GobEncode implements gob.GobEncoder interface
+func (n Uint32) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Uint32) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// Scan implements scanner interface
+func (n *Uint32) Scan(value interface{}) error {
+	if value == nil {
+		n.realValue, n.isValid = 0, false
+		return nil
 	}
 
-	parsed, err := strconv.ParseUint(scanned, radix, 32)
+	parsed, err := scanUint(value, 32, n)
 	if err != nil {
 		return err
 	}
@@ -108,6 +377,23 @@ func (n Uint32) Value() (driver.Value, error) {
 	return strconv.FormatUint(uint64(n.realValue), 10), nil
 }
 
+// FromSQLNullUint32 converts sql.NullInt64 to nullable 32-bit unsigned integer, fails with *OverflowError when out of range
+func FromSQLNullUint32(value sql.NullInt64) (Uint32, error) {
+	if !value.Valid {
+		return NewUint32(nil), nil
+	}
+	v := value.Int64
+	if v < 0 || int64(uint32(v)) != v {
+		return NewUint32(nil), overflowOf(v, new(Uint32))
+	}
+	return NewUint32Value(uint32(v)), nil
+}
+
+// SQLNull converts to sql.NullInt64
+func (n Uint32) SQLNull() sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(n.realValue), Valid: n.isValid}
+}
+
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint32) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	switch db.Dialector.Name() {
@@ -134,6 +420,16 @@ func (n Uint32) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	return clause.Expr{}
 }
 
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Uint32) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int64",
+		"minimum": 0,
+		"maximum": uint32(math.MaxUint32),
+	}
+}
+
 // GormDataType gorm common data type
 func (Uint32) GormDataType() string {
 	return "uint32_null"
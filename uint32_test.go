@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -93,6 +98,166 @@ func TestJSONUint32(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint32(nil))
 }
 
+func TestGQLUint32(t *testing.T) {
+	var basicInt1 uint32 = 37
+	marshalUnmarshalGQL(t, nullable.NewUint32(&basicInt1))
+
+	var basicInt2 uint32 = 1234
+	marshalUnmarshalGQL(t, nullable.NewUint32(&basicInt2))
+
+	var basicInt3 uint32 = 654321
+	marshalUnmarshalGQL(t, nullable.NewUint32(&basicInt3))
+
+	marshalUnmarshalGQL(t, nullable.NewUint32(nil))
+}
+
+func TestTextUint32(t *testing.T) {
+	var basicInt1 uint32 = 37
+	marshalUnmarshalText(t, nullable.NewUint32(&basicInt1))
+
+	var basicInt2 uint32 = 1234
+	marshalUnmarshalText(t, nullable.NewUint32(&basicInt2))
+
+	var basicInt3 uint32 = 654321
+	marshalUnmarshalText(t, nullable.NewUint32(&basicInt3))
+
+	marshalUnmarshalText(t, nullable.NewUint32(nil))
+}
+
+func TestBinaryUint32(t *testing.T) {
+	var basicInt1 uint32 = 37
+	marshalUnmarshalBinary(t, nullable.NewUint32(&basicInt1))
+
+	var basicInt2 uint32 = 1234
+	marshalUnmarshalBinary(t, nullable.NewUint32(&basicInt2))
+
+	var basicInt3 uint32 = 654321
+	marshalUnmarshalBinary(t, nullable.NewUint32(&basicInt3))
+
+	marshalUnmarshalBinary(t, nullable.NewUint32(nil))
+}
+
+func TestAccessorUint32(t *testing.T) {
+	nullableValue := nullable.NewUint32Value(uint32(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(uint32(1)), uint32(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), uint32(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), uint32(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v uint32) uint32 { return v + 1 }).MustGet(), uint32(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(uint32) nullable.Uint32 { return nullable.NewUint32(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint32) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint32) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Uint32](uint32(37)), nullableValue)
+
+	nullValue := nullable.NewUint32(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(uint32(1)), uint32(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), uint32(0))
+	tests.AssertEqual(t, nullValue.Map(func(v uint32) uint32 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(uint32) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullUint32(t *testing.T) {
+	converted, err := nullable.FromSQLNullUint32(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullUint32(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewUint32Value(37).SQLNull(), sql.NullInt64{Int64: 37, Valid: true})
+	tests.AssertEqual(t, nullable.NewUint32(nil).SQLNull(), sql.NullInt64{})
+
+	if _, err := nullable.FromSQLNullUint32(sql.NullInt64{Int64: 4294967296, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting 4294967296 to Uint32, got %v", err)
+	}
+}
+
+func TestArithmeticUint32(t *testing.T) {
+	a, b := nullable.NewUint32Value(7), nullable.NewUint32Value(2)
+	null := nullable.NewUint32(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), uint32(9))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), uint32(5))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), uint32(14))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), uint32(3))
+
+	max, one := nullable.NewUint32Value(math.MaxUint32), nullable.NewUint32Value(1)
+	tests.AssertEqual(t, max.Add(one).MustGet(), uint32(0))
+	overflows := []func() (nullable.Uint32, error){
+		func() (nullable.Uint32, error) { return max.AddChecked(one) },
+		func() (nullable.Uint32, error) { return b.SubChecked(a) },
+		func() (nullable.Uint32, error) { return max.MulChecked(b) },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	product, err := a.MulChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, product.MustGet(), uint32(14))
+
+	quotient, err = a.Div(nullable.NewUint32Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewUint32Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareUint32(t *testing.T) {
+	low, high := nullable.NewUint32Value(uint32(3)), nullable.NewUint32Value(uint32(37))
+	null := nullable.NewUint32(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewUint32Value(uint32(3)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewUint32(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewUint32Value(uint32(3))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewUint32(nil)), true)
+}
+
+func TestFormatUint32(t *testing.T) {
+	value := nullable.NewUint32Value(42)
+	null := nullable.NewUint32(nil)
+	tests.AssertEqual(t, value.String(), "42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `42|"42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewUint32Value(0x2a)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewUint32(nil)")
+}
+
+func TestLogValueUint32(t *testing.T) {
+	value := nullable.NewUint32Value(42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindUint64)
+	tests.AssertEqual(t, value.Uint64(), uint64(42))
+
+	null := nullable.NewUint32(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestUint32(t *testing.T) {
 	type TestNullableUint32 struct {
 		ID    uint32
@@ -1,9 +1,16 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"strconv"
 
 	"gorm.io/gorm"
@@ -31,6 +38,14 @@ func NewUint64(value *uint64) Uint64 {
 	}
 }
 
+// NewUint64Value creates a new valid nullable 64-bit unsigned integer from plain value
+func NewUint64Value(value uint64) Uint64 {
+	return Uint64{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 64-bit integer
 func (n Uint64) Get() *uint64 {
 	if !n.isValid {
@@ -49,22 +64,241 @@ func (n *Uint64) Set(value *uint64) {
 	}
 }
 
-// MarshalJSON converts current value to JSON
+// Valid returns true when current value is not NULL
+func (n Uint64) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Uint64) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Uint64) ValueOr(fallback uint64) uint64 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Uint64) ValueOrZero() uint64 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Uint64) MustGet() uint64 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Uint64")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Uint64) Clone() Uint64 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Uint64) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatUint(n.realValue, 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Uint64) GoString() string {
+	if !n.isValid {
+		return "nullable.NewUint64(nil)"
+	}
+	return fmt.Sprintf("nullable.NewUint64Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Uint64) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Uint64) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Uint64Value(n.realValue)
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Uint64) Map(fn func(uint64) uint64) Uint64 {
+	if !n.isValid {
+		return n
+	}
+	return NewUint64Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Uint64) FlatMap(fn func(uint64) Uint64) Uint64 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Uint64) Filter(fn func(uint64) bool) Uint64 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewUint64(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Uint64) Compare(other Uint64, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Uint64) Equal(other Uint64) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Uint64) Add(other Uint64) Uint64 {
+	if !n.isValid || !other.isValid {
+		return NewUint64(nil)
+	}
+	return NewUint64Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Uint64) Sub(other Uint64) Uint64 {
+	if !n.isValid || !other.isValid {
+		return NewUint64(nil)
+	}
+	return NewUint64Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Uint64) Mul(other Uint64) Uint64 {
+	if !n.isValid || !other.isValid {
+		return NewUint64(nil)
+	}
+	return NewUint64Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Uint64) Div(other Uint64) (Uint64, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint64(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewUint64(nil), divisionByZero()
+	}
+	return NewUint64Value(n.realValue / other.realValue), nil
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Uint64) AddChecked(other Uint64) (Uint64, error) {
+	return n.checked("+", other, addUnsigned[uint64])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Uint64) SubChecked(other Uint64) (Uint64, error) {
+	return n.checked("-", other, subUnsigned[uint64])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Uint64) MulChecked(other Uint64) (Uint64, error) {
+	return n.checked("*", other, mulUnsigned[uint64])
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Uint64) checked(operator string, other Uint64, fn func(a, b uint64) (uint64, bool)) (Uint64, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint64(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewUint64(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Uint64))
+	}
+	return NewUint64Value(result), nil
+}
+
+// MarshalJSON converts current value to JSON, see SetLargeIntJSON
 func (n Uint64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Uint64) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeUint(dst, n.realValue, isLargeIntAsString())
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, both number and quoted number are accepted, failures are *DecodeError
 func (n *Uint64) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Uint64) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
+	digits, isQuoted := unquoteLargeInt(data)
 	var parsed uint64
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if fast, isFast := parseJSONUint(digits, 64); isFast {
+		parsed = fast
+	} else if isQuoted {
+		quoted, err := parseQuotedUint(digits, 64)
+		if err != nil {
+			return err
+		}
+		parsed = quoted
+	} else {
+		var slow uint64
+		if err := json.Unmarshal(digits, &slow); err != nil {
+			return err
+		}
+		parsed = slow
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Uint64) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, n.realValue, 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Uint64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
 		return err
 	}
 
@@ -73,24 +307,65 @@ func (n *Uint64) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements scanner interface
-func (n *Uint64) Scan(value interface{}) error {
-	if value == nil {
-		n.realValue, n.isValid = 0, false
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Uint64) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Uint64) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Uint64) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, binary.MaxVarintLen64)
+	if n.isValid {
+		buffer = appendUvarint(buffer, n.realValue)
+	}
+	return buffer, nil
+}
+
+// UnmarshalBinary writes binary form to this type
+func (n *Uint64) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Uint64")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
 		return nil
 	}
 
-	var scanned string
-	if err := convertAssign(&scanned, value); err != nil {
+	parsed, err := readUvarint(payload, 64, "Uint64")
+	if err != nil {
 		return err
 	}
 
-	radix := 10
-	if len(scanned) == 64 {
-		radix = 2
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder interface
+func (n Uint64) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Uint64) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// Scan implements scanner interface
+func (n *Uint64) Scan(value interface{}) error {
+	if value == nil {
+		n.realValue, n.isValid = 0, false
+		return nil
 	}
 
-	parsed, err := strconv.ParseUint(scanned, radix, 64)
+	parsed, err := scanUint(value, 64, n)
 	if err != nil {
 		return err
 	}
@@ -108,6 +383,26 @@ func (n Uint64) Value() (driver.Value, error) {
 	return strconv.FormatUint(n.realValue, 10), nil
 }
 
+// FromSQLNullUint64 converts sql.NullInt64 to nullable 64-bit unsigned integer, fails with *OverflowError when out of range
+func FromSQLNullUint64(value sql.NullInt64) (Uint64, error) {
+	if !value.Valid {
+		return NewUint64(nil), nil
+	}
+	v := value.Int64
+	if v < 0 {
+		return NewUint64(nil), overflowOf(v, new(Uint64))
+	}
+	return NewUint64Value(uint64(v)), nil
+}
+
+// SQLNull converts to sql.NullInt64, fails with *OverflowError when current value exceeds int64
+func (n Uint64) SQLNull() (sql.NullInt64, error) {
+	if n.isValid && n.realValue > math.MaxInt64 {
+		return sql.NullInt64{}, overflowOf(n.realValue, new(int64))
+	}
+	return sql.NullInt64{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint64) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	switch db.Dialector.Name() {
@@ -134,6 +429,15 @@ func (n Uint64) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	return clause.Expr{}
 }
 
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Uint64) JSONSchema() map[string]interface{} {
+	return largeIntSchema(map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int64",
+		"minimum": 0,
+	}, isLargeIntAsString())
+}
+
 // GormDataType gorm common data type
 func (Uint64) GormDataType() string {
 	return "uint64_null"
@@ -149,3 +453,51 @@ func (Uint64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
 	}
 	return ""
 }
+
+// Uint64String is Uint64 which is always written to JSON as string, like "9007199254740993"
+type Uint64String struct {
+	Uint64
+}
+
+// NewUint64String creates a new nullable 64-bit unsigned integer which is written to JSON as string
+func NewUint64String(value *uint64) Uint64String {
+	return Uint64String{NewUint64(value)}
+}
+
+// Clone returns a copy
+func (n Uint64String) Clone() Uint64String {
+	return Uint64String{n.Uint64.Clone()}
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Uint64String) GoString() string {
+	return "nullable.Uint64String{Uint64: " + n.Uint64.GoString() + "}"
+}
+
+// Format implements fmt.Formatter, see Uint64.Format
+func (n Uint64String) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// MarshalJSON converts current value to JSON string
+func (n Uint64String) MarshalJSON() ([]byte, error) {
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON string of current value to dst without reflection
+func (n Uint64String) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return appendLargeUint(dst, n.realValue, true)
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Uint64String) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (n Uint64String) JSONSchema() map[string]interface{} {
+	return largeIntSchema(n.Uint64.JSONSchema(), true)
+}
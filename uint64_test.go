@@ -1,6 +1,12 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -114,6 +120,226 @@ func TestJSONUint64(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint64(nil))
 }
 
+func TestGQLUint64(t *testing.T) {
+	var basicInt1 uint64 = 37
+	marshalUnmarshalGQL(t, nullable.NewUint64(&basicInt1))
+
+	var basicInt2 uint64 = 1234
+	marshalUnmarshalGQL(t, nullable.NewUint64(&basicInt2))
+
+	var basicInt3 uint64 = 654321
+	marshalUnmarshalGQL(t, nullable.NewUint64(&basicInt3))
+
+	var basicInt4 uint64 = 50000000000
+	marshalUnmarshalGQL(t, nullable.NewUint64(&basicInt4))
+
+	marshalUnmarshalGQL(t, nullable.NewUint64(nil))
+}
+
+func TestJSONStringUint64(t *testing.T) {
+	var basicInt uint64 = 18446744073709551615
+	nullableInt := nullable.NewUint64(&basicInt)
+
+	serialized, _ := json.Marshal(nullableInt)
+	tests.AssertEqual(t, string(serialized), "18446744073709551615")
+
+	nullable.SetLargeIntJSON(nullable.LargeIntAsString)
+	serialized, _ = json.Marshal(nullableInt)
+	nullable.SetLargeIntJSON(nullable.LargeIntAsNumber)
+	tests.AssertEqual(t, string(serialized), `"18446744073709551615"`)
+
+	serialized, _ = json.Marshal(nullable.NewUint64String(&basicInt))
+	tests.AssertEqual(t, string(serialized), `"18446744073709551615"`)
+
+	serialized, _ = json.Marshal(nullable.NewUint64String(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	for _, input := range []string{"18446744073709551615", `"18446744073709551615"`} {
+		var unserialized nullable.Uint64
+		if err := json.Unmarshal([]byte(input), &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserialized.Get(), basicInt)
+
+		var unserializedString nullable.Uint64String
+		if err := json.Unmarshal([]byte(input), &unserializedString); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserializedString.Get(), basicInt)
+	}
+
+	for _, input := range []string{`"12a"`, `"null"`, `" 1"`, `"1 "`, `""`, `"1.5"`, `"99999999999999999999999"`, `"-1"`} {
+		var invalid nullable.Uint64
+		if err := json.Unmarshal([]byte(input), &invalid); err == nil {
+			t.Errorf("Expected error while unmarshalling malformed quoted number %s", input)
+		}
+		tests.AssertEqual(t, invalid.Valid(), false)
+	}
+}
+
+func TestTextUint64(t *testing.T) {
+	var basicInt1 uint64 = 37
+	marshalUnmarshalText(t, nullable.NewUint64(&basicInt1))
+
+	var basicInt2 uint64 = 1234
+	marshalUnmarshalText(t, nullable.NewUint64(&basicInt2))
+
+	var basicInt3 uint64 = 654321
+	marshalUnmarshalText(t, nullable.NewUint64(&basicInt3))
+
+	var basicInt4 uint64 = 50000000000
+	marshalUnmarshalText(t, nullable.NewUint64(&basicInt4))
+
+	marshalUnmarshalText(t, nullable.NewUint64(nil))
+}
+
+func TestBinaryUint64(t *testing.T) {
+	var basicInt1 uint64 = 37
+	marshalUnmarshalBinary(t, nullable.NewUint64(&basicInt1))
+
+	var basicInt2 uint64 = 1234
+	marshalUnmarshalBinary(t, nullable.NewUint64(&basicInt2))
+
+	var basicInt3 uint64 = 654321
+	marshalUnmarshalBinary(t, nullable.NewUint64(&basicInt3))
+
+	var basicInt4 uint64 = 50000000000
+	marshalUnmarshalBinary(t, nullable.NewUint64(&basicInt4))
+
+	marshalUnmarshalBinary(t, nullable.NewUint64(nil))
+}
+
+func TestAccessorUint64(t *testing.T) {
+	nullableValue := nullable.NewUint64Value(uint64(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(uint64(1)), uint64(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), uint64(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), uint64(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v uint64) uint64 { return v + 1 }).MustGet(), uint64(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(uint64) nullable.Uint64 { return nullable.NewUint64(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint64) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint64) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Uint64](uint64(37)), nullableValue)
+
+	nullValue := nullable.NewUint64(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(uint64(1)), uint64(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), uint64(0))
+	tests.AssertEqual(t, nullValue.Map(func(v uint64) uint64 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(uint64) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullUint64(t *testing.T) {
+	converted, err := nullable.FromSQLNullUint64(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullUint64(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	converted = nullable.NewUint64Value(37)
+	sqlNull, err := converted.SQLNull()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sqlNull, sql.NullInt64{Int64: 37, Valid: true})
+
+	sqlNull, err = nullable.NewUint64(nil).SQLNull()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sqlNull, sql.NullInt64{})
+
+	if _, err := nullable.NewUint64Value(math.MaxUint64).SQLNull(); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting max uint64 to sql.NullInt64, got %v", err)
+	}
+
+	if _, err := nullable.FromSQLNullUint64(sql.NullInt64{Int64: -1, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting -1 to Uint64, got %v", err)
+	}
+}
+
+func TestArithmeticUint64(t *testing.T) {
+	a, b := nullable.NewUint64Value(7), nullable.NewUint64Value(2)
+	null := nullable.NewUint64(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), uint64(9))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), uint64(5))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), uint64(14))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), uint64(3))
+
+	max, one := nullable.NewUint64Value(math.MaxUint64), nullable.NewUint64Value(1)
+	tests.AssertEqual(t, max.Add(one).MustGet(), uint64(0))
+	overflows := []func() (nullable.Uint64, error){
+		func() (nullable.Uint64, error) { return max.AddChecked(one) },
+		func() (nullable.Uint64, error) { return b.SubChecked(a) },
+		func() (nullable.Uint64, error) { return max.MulChecked(b) },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	product, err := a.MulChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, product.MustGet(), uint64(14))
+
+	quotient, err = a.Div(nullable.NewUint64Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewUint64Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareUint64(t *testing.T) {
+	low, high := nullable.NewUint64Value(uint64(3)), nullable.NewUint64Value(uint64(37))
+	null := nullable.NewUint64(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewUint64Value(uint64(3)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewUint64(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewUint64Value(uint64(3))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewUint64(nil)), true)
+}
+
+func TestFormatUint64(t *testing.T) {
+	value := nullable.NewUint64Value(42)
+	null := nullable.NewUint64(nil)
+	tests.AssertEqual(t, value.String(), "42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `42|"42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewUint64Value(0x2a)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewUint64(nil)")
+}
+
+func TestLogValueUint64(t *testing.T) {
+	value := nullable.NewUint64Value(42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindUint64)
+	tests.AssertEqual(t, value.Uint64(), uint64(42))
+
+	null := nullable.NewUint64(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestUint64(t *testing.T) {
 	type TestNullableUint64 struct {
 		ID    uint64
@@ -154,3 +380,29 @@ func TestUint64(t *testing.T) {
 	}
 	tests.AssertEqual(t, result2, neutron)
 }
+
+func TestUint64String(t *testing.T) {
+	type TestNullableUint64String struct {
+		ID    uint64
+		Name  string
+		Value nullable.Uint64String
+	}
+
+	DB.Migrator().DropTable(&TestNullableUint64String{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableUint64String{}); err != nil {
+		t.Errorf("failed to migrate nullable uint64 string, got error: %v", err)
+	}
+
+	var basicInt uint64 = 9007199254740993
+	record := TestNullableUint64String{
+		Name:  "unsafe",
+		Value: nullable.NewUint64String(&basicInt),
+	}
+	DB.Create(&record)
+
+	var result TestNullableUint64String
+	if err := DB.First(&result, "name = ?", "unsafe").Error; err != nil {
+		t.Fatal("Cannot read uint64 string test record of \"unsafe\"")
+	}
+	tests.AssertEqual(t, result.Value.Get(), basicInt)
+}
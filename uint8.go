@@ -1,9 +1,15 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
 	"strconv"
 
 	"gorm.io/gorm"
@@ -31,6 +37,14 @@ func NewUint8(value *uint8) Uint8 {
 	}
 }
 
+// NewUint8Value creates a new valid nullable 8-bit unsigned integer from plain value
+func NewUint8Value(value uint8) Uint8 {
+	return Uint8{
+		realValue: value,
+		isValid:   true,
+	}
+}
+
 // Get either nil or 8-bit unsigned integer
 func (n Uint8) Get() *uint8 {
 	if !n.isValid {
@@ -49,23 +63,209 @@ func (n *Uint8) Set(value *uint8) {
 	}
 }
 
+// Valid returns true when current value is not NULL
+func (n Uint8) Valid() bool {
+	return n.isValid
+}
+
+// IsNull returns true when current value is NULL
+func (n Uint8) IsNull() bool {
+	return !n.isValid
+}
+
+// ValueOr returns current value, or fallback when NULL
+func (n Uint8) ValueOr(fallback uint8) uint8 {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// ValueOrZero returns current value, or 0 when NULL
+func (n Uint8) ValueOrZero() uint8 {
+	return n.ValueOr(0)
+}
+
+// MustGet returns current value, panics when NULL
+func (n Uint8) MustGet() uint8 {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Uint8")
+	}
+	return n.realValue
+}
+
+// Clone returns a copy, which is the same as assignment for this type
+func (n Uint8) Clone() Uint8 {
+	return n
+}
+
+// String returns the value like fmt.Sprint, or the placeholder when NULL, see SetNullPlaceholder
+func (n Uint8) String() string {
+	if !n.isValid {
+		return currentNullPlaceholder()
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10)
+}
+
+// GoString returns Go syntax which creates this value, for %#v
+func (n Uint8) GoString() string {
+	if !n.isValid {
+		return "nullable.NewUint8(nil)"
+	}
+	return fmt.Sprintf("nullable.NewUint8Value(%#v)", n.realValue)
+}
+
+// Format implements fmt.Formatter, verbs like %5.2f apply to the value, NULL is the placeholder
+func (n Uint8) Format(f fmt.State, verb rune) {
+	formatNullable(f, verb, n.isValid, n.realValue, n)
+}
+
+// LogValue implements slog.LogValuer, NULL becomes nil which slog.JSONHandler writes as null
+func (n Uint8) LogValue() slog.Value {
+	if !n.isValid {
+		return nullLogValue()
+	}
+	return slog.Uint64Value(uint64(n.realValue))
+}
+
+// Map converts current value with fn, NULL stays NULL
+func (n Uint8) Map(fn func(uint8) uint8) Uint8 {
+	if !n.isValid {
+		return n
+	}
+	return NewUint8Value(fn(n.realValue))
+}
+
+// FlatMap converts current value with fn which may return NULL, NULL stays NULL
+func (n Uint8) FlatMap(fn func(uint8) Uint8) Uint8 {
+	if !n.isValid {
+		return n
+	}
+	return fn(n.realValue)
+}
+
+// Filter keeps current value when fn returns true, otherwise returns NULL
+func (n Uint8) Filter(fn func(uint8) bool) Uint8 {
+	if !n.isValid || !fn(n.realValue) {
+		return NewUint8(nil)
+	}
+	return n
+}
+
+// Compare returns -1, 0, or +1 like cmp.Compare, order decides where NULL goes
+func (n Uint8) Compare(other Uint8, order NullOrder) int {
+	if result, isDecided := compareValidity(n.isValid, other.isValid, order); isDecided {
+		return result
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// Equal returns true when both are NULL, or both hold the same value
+func (n Uint8) Equal(other Uint8) bool {
+	return n.isValid == other.isValid && (!n.isValid || n.realValue == other.realValue)
+}
+
+// Add returns n + other, NULL when either is NULL, overflow wraps around like Go, see AddChecked
+func (n Uint8) Add(other Uint8) Uint8 {
+	if !n.isValid || !other.isValid {
+		return NewUint8(nil)
+	}
+	return NewUint8Value(n.realValue + other.realValue)
+}
+
+// Sub returns n - other, NULL when either is NULL, overflow wraps around like Go, see SubChecked
+func (n Uint8) Sub(other Uint8) Uint8 {
+	if !n.isValid || !other.isValid {
+		return NewUint8(nil)
+	}
+	return NewUint8Value(n.realValue - other.realValue)
+}
+
+// Mul returns n * other, NULL when either is NULL, overflow wraps around like Go, see MulChecked
+func (n Uint8) Mul(other Uint8) Uint8 {
+	if !n.isValid || !other.isValid {
+		return NewUint8(nil)
+	}
+	return NewUint8Value(n.realValue * other.realValue)
+}
+
+// Div returns n / other truncated toward zero, NULL when either is NULL, see SetDivisionPolicy for zero divisor
+func (n Uint8) Div(other Uint8) (Uint8, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint8(nil), nil
+	}
+	if other.realValue == 0 {
+		return NewUint8(nil), divisionByZero()
+	}
+	return NewUint8Value(n.realValue / other.realValue), nil
+}
+
+// AddChecked is Add, but fails with *OverflowError instead of wrapping around
+func (n Uint8) AddChecked(other Uint8) (Uint8, error) {
+	return n.checked("+", other, addUnsigned[uint8])
+}
+
+// SubChecked is Sub, but fails with *OverflowError instead of wrapping around
+func (n Uint8) SubChecked(other Uint8) (Uint8, error) {
+	return n.checked("-", other, subUnsigned[uint8])
+}
+
+// MulChecked is Mul, but fails with *OverflowError instead of wrapping around
+func (n Uint8) MulChecked(other Uint8) (Uint8, error) {
+	return n.checked("*", other, mulUnsigned[uint8])
+}
+
+// checked applies overflow-checked operation, NULL when either is NULL
+func (n Uint8) checked(operator string, other Uint8, fn func(a, b uint8) (uint8, bool)) (Uint8, error) {
+	if !n.isValid || !other.isValid {
+		return NewUint8(nil), nil
+	}
+	result, isOverflow := fn(n.realValue, other.realValue)
+	if isOverflow {
+		return NewUint8(nil), overflowOf(operation{operator: operator, left: n.realValue, right: other.realValue}, new(Uint8))
+	}
+	return NewUint8Value(result), nil
+}
+
 // MarshalJSON converts current value to JSON
 func (n Uint8) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	return n.AppendJSON(nil), nil
+}
+
+// AppendJSON appends JSON form of current value to dst without reflection
+func (n Uint8) AppendJSON(dst []byte) []byte {
+	if !n.isValid {
+		return append(dst, "null"...)
+	}
+	return strconv.AppendUint(dst, uint64(n.realValue), 10)
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, failures are *DecodeError
 func (n *Uint8) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	return newDecodeError(n, data, n.unmarshalJSON(data))
+}
+
+// unmarshalJSON decodes JSON, the error is wrapped by UnmarshalJSON
+func (n *Uint8) unmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
+	if isLenientJSON() {
+		return readLenientJSON(n, data)
+	}
+
 	var parsed uint8
-	if err := json.Unmarshal(data, &parsed); err != nil {
-		return err
+	if fast, isFast := parseJSONUint(data, 8); isFast {
+		parsed = uint8(fast)
+	} else {
+		var slow uint8
+		if err := json.Unmarshal(data, &slow); err != nil {
+			return err
+		}
+		parsed = slow
 	}
 
 	n.isValid = true
@@ -73,24 +273,91 @@ func (n *Uint8) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements scanner interface
-func (n *Uint8) Scan(value interface{}) error {
-	if value == nil {
-		n.realValue, n.isValid = 0, false
+// MarshalText converts current value to plain text, NULL becomes empty text
+func (n Uint8) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes plain text to this type, empty text becomes NULL
+func (n *Uint8) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
 		return nil
 	}
 
-	var scanned string
-	if err := convertAssign(&scanned, value); err != nil {
+	parsed, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
 		return err
 	}
 
-	radix := 10
-	if len(scanned) == 8 {
-		radix = 2
+	n.isValid = true
+	n.realValue = uint8(parsed)
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface of gqlgen
+func (n Uint8) MarshalGQL(w io.Writer) {
+	writeGQL(w, n)
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface of gqlgen
+func (n *Uint8) UnmarshalGQL(v interface{}) error {
+	return readGQL(n, v)
+}
+
+// MarshalBinary converts current value to compact binary form
+func (n Uint8) MarshalBinary() ([]byte, error) {
+	buffer := newBinary(n.isValid, 1)
+	if n.isValid {
+		buffer = append(buffer, byte(n.realValue))
 	}
+	return buffer, nil
+}
 
-	parsed, err := strconv.ParseUint(scanned, radix, 8)
+// UnmarshalBinary writes binary form to this type
+func (n *Uint8) UnmarshalBinary(data []byte) error {
+	payload, isValid, err := readBinary(data, "Uint8")
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	if err := readFixed(payload, 1, "Uint8"); err != nil {
+		return err
+	}
+	parsed := uint8(payload[0])
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder interface
+func (n Uint8) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder interface
+func (n *Uint8) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
+
+// Scan implements scanner interface
+func (n *Uint8) Scan(value interface{}) error {
+	if value == nil {
+		n.realValue, n.isValid = 0, false
+		return nil
+	}
+
+	parsed, err := scanUint(value, 8, n)
 	if err != nil {
 		return err
 	}
@@ -108,6 +375,31 @@ func (n Uint8) Value() (driver.Value, error) {
 	return strconv.FormatUint(uint64(n.realValue), 10), nil
 }
 
+// FromSQLNullUint8 converts sql.NullByte to nullable 8-bit unsigned integer
+func FromSQLNullUint8(value sql.NullByte) Uint8 {
+	if !value.Valid {
+		return NewUint8(nil)
+	}
+	return NewUint8Value(value.Byte)
+}
+
+// FromSQLNullInt64ToUint8 converts sql.NullInt64 to nullable 8-bit unsigned integer, fails with *OverflowError when out of range
+func FromSQLNullInt64ToUint8(value sql.NullInt64) (Uint8, error) {
+	if !value.Valid {
+		return NewUint8(nil), nil
+	}
+	v := value.Int64
+	if v < 0 || int64(uint8(v)) != v {
+		return NewUint8(nil), overflowOf(v, new(Uint8))
+	}
+	return NewUint8Value(uint8(v)), nil
+}
+
+// SQLNull converts to sql.NullByte
+func (n Uint8) SQLNull() sql.NullByte {
+	return sql.NullByte{Byte: n.realValue, Valid: n.isValid}
+}
+
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint8) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	switch db.Dialector.Name() {
@@ -134,6 +426,16 @@ func (n Uint8) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 	return clause.Expr{}
 }
 
+// JSONSchema describes JSON form of this type in JSON Schema (OpenAPI 3.1) dialect
+func (Uint8) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":    []string{"integer", "null"},
+		"format":  "int32",
+		"minimum": 0,
+		"maximum": math.MaxUint8,
+	}
+}
+
 // GormDataType gorm common data type
 func (Uint8) GormDataType() string {
 	return "uint8_null"
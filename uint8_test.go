@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -50,6 +55,155 @@ func TestJSONUint8(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint8(nil))
 }
 
+func TestGQLUint8(t *testing.T) {
+	var basicInt1 uint8 = 37
+	marshalUnmarshalGQL(t, nullable.NewUint8(&basicInt1))
+
+	marshalUnmarshalGQL(t, nullable.NewUint8(nil))
+}
+
+func TestTextUint8(t *testing.T) {
+	var basicInt1 uint8 = 37
+	marshalUnmarshalText(t, nullable.NewUint8(&basicInt1))
+
+	marshalUnmarshalText(t, nullable.NewUint8(nil))
+}
+
+func TestBinaryUint8(t *testing.T) {
+	var basicInt1 uint8 = 37
+	marshalUnmarshalBinary(t, nullable.NewUint8(&basicInt1))
+
+	marshalUnmarshalBinary(t, nullable.NewUint8(nil))
+}
+
+func TestAccessorUint8(t *testing.T) {
+	nullableValue := nullable.NewUint8Value(uint8(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(uint8(1)), uint8(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), uint8(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), uint8(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v uint8) uint8 { return v + 1 }).MustGet(), uint8(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(uint8) nullable.Uint8 { return nullable.NewUint8(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint8) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint8) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Uint8](uint8(37)), nullableValue)
+
+	nullValue := nullable.NewUint8(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(uint8(1)), uint8(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), uint8(0))
+	tests.AssertEqual(t, nullValue.Map(func(v uint8) uint8 { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(uint8) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullUint8(t *testing.T) {
+	tests.AssertEqual(t, nullable.FromSQLNullUint8(sql.NullByte{Byte: 37, Valid: true}).Get(), 37)
+	tests.AssertEqual(t, nullable.FromSQLNullUint8(sql.NullByte{}).Get(), nil)
+
+	tests.AssertEqual(t, nullable.NewUint8Value(37).SQLNull(), sql.NullByte{Byte: 37, Valid: true})
+	tests.AssertEqual(t, nullable.NewUint8(nil).SQLNull(), sql.NullByte{})
+}
+
+func TestSQLNullInt64ToUint8(t *testing.T) {
+	converted, err := nullable.FromSQLNullInt64ToUint8(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullInt64ToUint8(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	for _, outOfRange := range []int64{256, -1} {
+		if _, err := nullable.FromSQLNullInt64ToUint8(sql.NullInt64{Int64: outOfRange, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+			t.Errorf("Expected overflow while converting %d to Uint8, got %v", outOfRange, err)
+		}
+	}
+}
+
+func TestArithmeticUint8(t *testing.T) {
+	a, b := nullable.NewUint8Value(7), nullable.NewUint8Value(2)
+	null := nullable.NewUint8(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), uint8(9))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), uint8(5))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), uint8(14))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), uint8(3))
+
+	max, one := nullable.NewUint8Value(math.MaxUint8), nullable.NewUint8Value(1)
+	tests.AssertEqual(t, max.Add(one).MustGet(), uint8(0))
+	overflows := []func() (nullable.Uint8, error){
+		func() (nullable.Uint8, error) { return max.AddChecked(one) },
+		func() (nullable.Uint8, error) { return b.SubChecked(a) },
+		func() (nullable.Uint8, error) { return max.MulChecked(b) },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	product, err := a.MulChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, product.MustGet(), uint8(14))
+
+	quotient, err = a.Div(nullable.NewUint8Value(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewUint8Value(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareUint8(t *testing.T) {
+	low, high := nullable.NewUint8Value(uint8(3)), nullable.NewUint8Value(uint8(37))
+	null := nullable.NewUint8(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewUint8Value(uint8(3)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewUint8(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewUint8Value(uint8(3))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewUint8(nil)), true)
+}
+
+func TestFormatUint8(t *testing.T) {
+	value := nullable.NewUint8Value(42)
+	null := nullable.NewUint8(nil)
+	tests.AssertEqual(t, value.String(), "42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `42|"42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewUint8Value(0x2a)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewUint8(nil)")
+}
+
+func TestLogValueUint8(t *testing.T) {
+	value := nullable.NewUint8Value(42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindUint64)
+	tests.AssertEqual(t, value.Uint64(), uint64(42))
+
+	null := nullable.NewUint8(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestUint8(t *testing.T) {
 	type TestNullableUint8 struct {
 		ID    uint
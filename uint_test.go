@@ -1,6 +1,12 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
 	"testing"
 
 	"github.com/Thor-x86/nullable"
@@ -98,6 +104,226 @@ func TestJSONUint(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint(nil))
 }
 
+func TestGQLUint(t *testing.T) {
+	var basicInt1 uint = 37
+	marshalUnmarshalGQL(t, nullable.NewUint(&basicInt1))
+
+	var basicInt2 uint = 1234
+	marshalUnmarshalGQL(t, nullable.NewUint(&basicInt2))
+
+	var basicInt3 uint = 654321
+	marshalUnmarshalGQL(t, nullable.NewUint(&basicInt3))
+
+	var basicInt4 uint = 50000000000
+	marshalUnmarshalGQL(t, nullable.NewUint(&basicInt4))
+
+	marshalUnmarshalGQL(t, nullable.NewUint(nil))
+}
+
+func TestJSONStringUint(t *testing.T) {
+	var basicInt uint = 18446744073709551615
+	nullableInt := nullable.NewUint(&basicInt)
+
+	serialized, _ := json.Marshal(nullableInt)
+	tests.AssertEqual(t, string(serialized), "18446744073709551615")
+
+	nullable.SetLargeIntJSON(nullable.LargeIntAsString)
+	serialized, _ = json.Marshal(nullableInt)
+	nullable.SetLargeIntJSON(nullable.LargeIntAsNumber)
+	tests.AssertEqual(t, string(serialized), `"18446744073709551615"`)
+
+	serialized, _ = json.Marshal(nullable.NewUintString(&basicInt))
+	tests.AssertEqual(t, string(serialized), `"18446744073709551615"`)
+
+	serialized, _ = json.Marshal(nullable.NewUintString(nil))
+	tests.AssertEqual(t, string(serialized), "null")
+
+	for _, input := range []string{"18446744073709551615", `"18446744073709551615"`} {
+		var unserialized nullable.Uint
+		if err := json.Unmarshal([]byte(input), &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserialized.Get(), basicInt)
+
+		var unserializedString nullable.UintString
+		if err := json.Unmarshal([]byte(input), &unserializedString); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserializedString.Get(), basicInt)
+	}
+
+	for _, input := range []string{`"12a"`, `"null"`, `" 1"`, `"1 "`, `""`, `"1.5"`, `"99999999999999999999999"`, `"-1"`} {
+		var invalid nullable.Uint
+		if err := json.Unmarshal([]byte(input), &invalid); err == nil {
+			t.Errorf("Expected error while unmarshalling malformed quoted number %s", input)
+		}
+		tests.AssertEqual(t, invalid.Valid(), false)
+	}
+}
+
+func TestTextUint(t *testing.T) {
+	var basicInt1 uint = 37
+	marshalUnmarshalText(t, nullable.NewUint(&basicInt1))
+
+	var basicInt2 uint = 1234
+	marshalUnmarshalText(t, nullable.NewUint(&basicInt2))
+
+	var basicInt3 uint = 654321
+	marshalUnmarshalText(t, nullable.NewUint(&basicInt3))
+
+	var basicInt4 uint = 50000000000
+	marshalUnmarshalText(t, nullable.NewUint(&basicInt4))
+
+	marshalUnmarshalText(t, nullable.NewUint(nil))
+}
+
+func TestBinaryUint(t *testing.T) {
+	var basicInt1 uint = 37
+	marshalUnmarshalBinary(t, nullable.NewUint(&basicInt1))
+
+	var basicInt2 uint = 1234
+	marshalUnmarshalBinary(t, nullable.NewUint(&basicInt2))
+
+	var basicInt3 uint = 654321
+	marshalUnmarshalBinary(t, nullable.NewUint(&basicInt3))
+
+	var basicInt4 uint = 50000000000
+	marshalUnmarshalBinary(t, nullable.NewUint(&basicInt4))
+
+	marshalUnmarshalBinary(t, nullable.NewUint(nil))
+}
+
+func TestAccessorUint(t *testing.T) {
+	nullableValue := nullable.NewUintValue(uint(37))
+	tests.AssertEqual(t, nullableValue.Valid(), true)
+	tests.AssertEqual(t, nullableValue.IsNull(), false)
+	tests.AssertEqual(t, nullableValue.ValueOr(uint(1)), uint(37))
+	tests.AssertEqual(t, nullableValue.ValueOrZero(), uint(37))
+	tests.AssertEqual(t, nullableValue.MustGet(), uint(37))
+	tests.AssertEqual(t, nullableValue.Map(func(v uint) uint { return v + 1 }).MustGet(), uint(38))
+	tests.AssertEqual(t, nullableValue.FlatMap(func(uint) nullable.Uint { return nullable.NewUint(nil) }).IsNull(), true)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint) bool { return true }), nullableValue)
+	tests.AssertEqual(t, nullableValue.Filter(func(uint) bool { return false }).IsNull(), true)
+	tests.AssertEqual(t, nullable.Of[nullable.Uint](uint(37)), nullableValue)
+
+	nullValue := nullable.NewUint(nil)
+	tests.AssertEqual(t, nullValue.Valid(), false)
+	tests.AssertEqual(t, nullValue.IsNull(), true)
+	tests.AssertEqual(t, nullValue.ValueOr(uint(1)), uint(1))
+	tests.AssertEqual(t, nullValue.ValueOrZero(), uint(0))
+	tests.AssertEqual(t, nullValue.Map(func(v uint) uint { return v + 1 }).IsNull(), true)
+	tests.AssertEqual(t, nullValue.Filter(func(uint) bool { return true }).IsNull(), true)
+}
+
+func TestSQLNullUint(t *testing.T) {
+	converted, err := nullable.FromSQLNullUint(sql.NullInt64{Int64: 37, Valid: true})
+	if err != nil {
+		t.Fatalf("Failed to convert sql.NullInt64 because: %s", err)
+	}
+	tests.AssertEqual(t, converted.Get(), 37)
+
+	converted, err = nullable.FromSQLNullUint(sql.NullInt64{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted.Get(), nil)
+
+	converted = nullable.NewUintValue(37)
+	sqlNull, err := converted.SQLNull()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sqlNull, sql.NullInt64{Int64: 37, Valid: true})
+
+	sqlNull, err = nullable.NewUint(nil).SQLNull()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sqlNull, sql.NullInt64{})
+
+	if _, err := nullable.NewUintValue(math.MaxUint64).SQLNull(); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting max uint to sql.NullInt64, got %v", err)
+	}
+
+	if _, err := nullable.FromSQLNullUint(sql.NullInt64{Int64: -1, Valid: true}); !errors.Is(err, nullable.ErrOverflow) {
+		t.Errorf("Expected overflow while converting -1 to Uint, got %v", err)
+	}
+}
+
+func TestArithmeticUint(t *testing.T) {
+	a, b := nullable.NewUintValue(7), nullable.NewUintValue(2)
+	null := nullable.NewUint(nil)
+	tests.AssertEqual(t, a.Add(b).MustGet(), uint(9))
+	tests.AssertEqual(t, a.Sub(b).MustGet(), uint(5))
+	tests.AssertEqual(t, a.Mul(b).MustGet(), uint(14))
+	tests.AssertEqual(t, a.Add(null).IsNull(), true)
+	tests.AssertEqual(t, null.Mul(b).IsNull(), true)
+
+	quotient, err := a.Div(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.MustGet(), uint(3))
+
+	max, one := nullable.NewUintValue(math.MaxUint), nullable.NewUintValue(1)
+	tests.AssertEqual(t, max.Add(one).MustGet(), uint(0))
+	overflows := []func() (nullable.Uint, error){
+		func() (nullable.Uint, error) { return max.AddChecked(one) },
+		func() (nullable.Uint, error) { return b.SubChecked(a) },
+		func() (nullable.Uint, error) { return max.MulChecked(b) },
+	}
+	for i, overflow := range overflows {
+		if result, err := overflow(); !errors.Is(err, nullable.ErrOverflow) || !result.IsNull() {
+			t.Errorf("Expected overflow in checked operation #%d, got %v", i, err)
+		}
+	}
+
+	product, err := a.MulChecked(b)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, product.MustGet(), uint(14))
+
+	quotient, err = a.Div(nullable.NewUintValue(0))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, quotient.IsNull(), true)
+
+	nullable.SetDivisionPolicy(nullable.DivisionError)
+	defer nullable.SetDivisionPolicy(nullable.DivisionNull)
+	if _, err := a.Div(nullable.NewUintValue(0)); !errors.Is(err, nullable.ErrDivisionByZero) {
+		t.Errorf("Expected division by zero error, got %v", err)
+	}
+}
+
+func TestCompareUint(t *testing.T) {
+	low, high := nullable.NewUintValue(uint(3)), nullable.NewUintValue(uint(37))
+	null := nullable.NewUint(nil)
+	tests.AssertEqual(t, low.Compare(high, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, high.Compare(low, nullable.NullsFirst), 1)
+	tests.AssertEqual(t, low.Compare(nullable.NewUintValue(uint(3)), nullable.NullsFirst), 0)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsFirst), -1)
+	tests.AssertEqual(t, null.Compare(low, nullable.NullsLast), 1)
+	tests.AssertEqual(t, high.Compare(null, nullable.NullsLast), -1)
+	tests.AssertEqual(t, null.Compare(nullable.NewUint(nil), nullable.NullsLast), 0)
+	tests.AssertEqual(t, low.Equal(nullable.NewUintValue(uint(3))), true)
+	tests.AssertEqual(t, low.Equal(high), false)
+	tests.AssertEqual(t, low.Equal(null), false)
+	tests.AssertEqual(t, null.Equal(nullable.NewUint(nil)), true)
+}
+
+func TestFormatUint(t *testing.T) {
+	value := nullable.NewUintValue(42)
+	null := nullable.NewUint(nil)
+	tests.AssertEqual(t, value.String(), "42")
+	tests.AssertEqual(t, null.String(), "NULL")
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", value, value), `42|"42"`)
+	tests.AssertEqual(t, fmt.Sprintf("%s|%q", null, null), `NULL|"NULL"`)
+	tests.AssertEqual(t, fmt.Sprint(value), "42")
+	tests.AssertEqual(t, fmt.Sprintf("%6v|%-6v|", null, null), "  NULL|NULL  |")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", value), "nullable.NewUintValue(0x2a)")
+	tests.AssertEqual(t, fmt.Sprintf("%#v", null), "nullable.NewUint(nil)")
+}
+
+func TestLogValueUint(t *testing.T) {
+	value := nullable.NewUintValue(42).LogValue()
+	tests.AssertEqual(t, value.Kind(), slog.KindUint64)
+	tests.AssertEqual(t, value.Uint64(), uint64(42))
+
+	null := nullable.NewUint(nil).LogValue()
+	tests.AssertEqual(t, null.Kind(), slog.KindAny)
+	tests.AssertEqual(t, null.Any(), nil)
+}
+
 func TestUint(t *testing.T) {
 	type TestNullableUint struct {
 		ID    uint